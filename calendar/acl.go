@@ -0,0 +1,167 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// ListACL retrieves the access control rules of a calendar.
+// An empty calendarID refers to the configured calendar.
+func (s *googleCalendarService) ListACL(ctx context.Context, calendarID string) ([]*ACLRule, error) {
+	calendarID = s.calendarIDOrDefault(calendarID)
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*ACLRule
+	err = service.Acl.List(calendarID).Pages(ctx, func(page *calendar.Acl) error {
+		for _, rule := range page.Items {
+			rules = append(rules, convertACLRule(rule))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, mapACLError(err, calendarID, "list sharing rules of")
+	}
+
+	return rules, nil
+}
+
+// ShareCalendar grants a user, group, domain or the public access to a calendar.
+// Sharing with a scope that already has a rule replaces its role.
+func (s *googleCalendarService) ShareCalendar(ctx context.Context, req *ShareCalendarRequest) (*ACLRule, error) {
+	if err := validateACLRule(req.Role, req.ScopeType, req.ScopeValue); err != nil {
+		return nil, err
+	}
+
+	calendarID := s.calendarIDOrDefault(req.CalendarID)
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &calendar.AclRule{
+		Role: req.Role,
+		Scope: &calendar.AclRuleScope{
+			Type:  req.ScopeType,
+			Value: req.ScopeValue,
+		},
+	}
+
+	created, err := service.Acl.Insert(calendarID, rule).
+		SendNotifications(req.SendNotifications).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, mapACLError(err, calendarID, "share")
+	}
+
+	log.Printf("Shared calendar %s with %s %s as %s", calendarID, req.ScopeType, req.ScopeValue, req.Role)
+	return convertACLRule(created), nil
+}
+
+// UnshareCalendar removes an access control rule from a calendar
+func (s *googleCalendarService) UnshareCalendar(ctx context.Context, calendarID, ruleID string) error {
+	if ruleID == "" {
+		return NewInvalidInputError(ErrCodeInvalidACLRule, "Rule ID is required", "")
+	}
+
+	calendarID = s.calendarIDOrDefault(calendarID)
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Acl.Delete(calendarID, ruleID).Context(ctx).Do(); err != nil {
+		if strings.Contains(err.Error(), "notFound") {
+			return NewNotFoundError(ErrCodeACLRuleNotFound, fmt.Sprintf("Sharing rule %s not found on calendar %s", ruleID, calendarID))
+		}
+		return mapACLError(err, calendarID, "unshare")
+	}
+
+	log.Printf("Removed sharing rule %s from calendar %s", ruleID, calendarID)
+	return nil
+}
+
+// aclRuleID returns the rule ID Google assigns to a scope, e.g. "user:jane@example.com"
+func aclRuleID(scopeType, scopeValue string) string {
+	if scopeType == ACLScopeDefault {
+		return ACLScopeDefault
+	}
+	return scopeType + ":" + scopeValue
+}
+
+// validateACLRule validates the role and scope of an access control rule
+func validateACLRule(role, scopeType, scopeValue string) error {
+	switch role {
+	case AccessRoleFreeBusyReader, AccessRoleReader, AccessRoleWriter, AccessRoleOwner:
+	default:
+		return NewInvalidInputError(ErrCodeInvalidACLRule, fmt.Sprintf("Invalid role: %s", role),
+			"Role must be one of: freeBusyReader, reader, writer, owner")
+	}
+
+	switch scopeType {
+	case ACLScopeUser, ACLScopeGroup:
+		if !strings.Contains(scopeValue, "@") {
+			return NewInvalidInputError(ErrCodeInvalidACLRule, fmt.Sprintf("Invalid email for %s scope: %q", scopeType, scopeValue),
+				"User and group scopes require an email address")
+		}
+	case ACLScopeDomain:
+		if scopeValue == "" || strings.Contains(scopeValue, "@") || !strings.Contains(scopeValue, ".") {
+			return NewInvalidInputError(ErrCodeInvalidACLRule, fmt.Sprintf("Invalid domain: %q", scopeValue),
+				"Domain scope requires a domain name such as example.com")
+		}
+	case ACLScopeDefault:
+		if scopeValue != "" {
+			return NewInvalidInputError(ErrCodeInvalidACLRule, "Default scope does not take a value",
+				"The default scope applies to everyone")
+		}
+		// Making a calendar publicly writable is almost never intended
+		if role == AccessRoleWriter || role == AccessRoleOwner {
+			return NewInvalidInputError(ErrCodeInvalidACLRule, fmt.Sprintf("Role %s cannot be granted to the default scope", role),
+				"The public can only be granted freeBusyReader or reader access")
+		}
+	default:
+		return NewInvalidInputError(ErrCodeInvalidACLRule, fmt.Sprintf("Invalid scope type: %s", scopeType),
+			"Scope type must be one of: user, group, domain, default")
+	}
+
+	return nil
+}
+
+// mapACLError converts a Google API error for a sharing operation into a CalendarError
+func mapACLError(err error, calendarID, action string) error {
+	if strings.Contains(err.Error(), "notFound") {
+		return NewNotFoundError(ErrCodeCalendarNotFound, fmt.Sprintf("Calendar not found: %s", calendarID))
+	}
+	// Only owners can manage sharing; Google reports this as forbidden (403)
+	if strings.Contains(err.Error(), "forbidden") || strings.Contains(err.Error(), "Error 403") {
+		return NewPermissionError(ErrCodePermissionDenied,
+			fmt.Sprintf("Permission denied to %s calendar %s; only calendar owners can manage sharing", action, calendarID))
+	}
+	if strings.Contains(err.Error(), "invalid") {
+		return NewInvalidInputError(ErrCodeInvalidACLRule, fmt.Sprintf("Google Calendar rejected the sharing rule for %s", calendarID), err.Error())
+	}
+	return NewInternalError(ErrCodeServiceUnavailable, fmt.Sprintf("Failed to %s calendar", action), err)
+}
+
+// convertACLRule converts a Google ACL rule to our ACLRule struct
+func convertACLRule(rule *calendar.AclRule) *ACLRule {
+	aclRule := &ACLRule{
+		ID:   rule.Id,
+		Role: rule.Role,
+	}
+	if rule.Scope != nil {
+		aclRule.ScopeType = rule.Scope.Type
+		aclRule.ScopeValue = rule.Scope.Value
+	}
+	return aclRule
+}
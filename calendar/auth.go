@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"golang.org/x/oauth2/google"
+	admin "google.golang.org/api/admin/directory/v1"
 	"google.golang.org/api/calendar/v3"
 	"google.golang.org/api/option"
 )
@@ -42,6 +43,39 @@ func (a *AuthManager) GetCalendarService(ctx context.Context) (*calendar.Service
 	return service, nil
 }
 
+// GetDirectoryService returns an Admin SDK Directory service for reading
+// Workspace calendar resources. Only administrators can list resources, so
+// the service account must have domain-wide delegation and act as the
+// configured directory admin.
+func (a *AuthManager) GetDirectoryService(ctx context.Context) (*admin.Service, error) {
+	if a.config.DirectoryAdmin == "" {
+		return nil, NewConfigurationError(ErrCodeConfigurationError,
+			"No room catalog configured. Set GOOGLE_CALENDAR_ROOMS or GOOGLE_CALENDAR_DIRECTORY_ADMIN", nil)
+	}
+
+	creds, err := a.loadCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.isServiceAccount(creds) {
+		return nil, NewAuthenticationError(ErrCodeInvalidCredentials, "Listing Workspace resources requires service account credentials", nil)
+	}
+
+	config, err := google.JWTConfigFromJSON(creds, admin.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, NewAuthenticationError(ErrCodeInvalidCredentials, "Failed to parse service account credentials", err)
+	}
+	config.Subject = a.config.DirectoryAdmin
+
+	service, err := admin.NewService(ctx, option.WithHTTPClient(config.Client(ctx)))
+	if err != nil {
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to create directory service", err)
+	}
+
+	return service, nil
+}
+
 // getAuthenticatedClient returns an authenticated HTTP client
 func (a *AuthManager) getAuthenticatedClient(ctx context.Context) (*http.Client, error) {
 	// Try to load credentials
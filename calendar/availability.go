@@ -0,0 +1,197 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// MinAvailabilitySlotSize is the smallest bookable slot size accepted
+const MinAvailabilitySlotSize = 5 * time.Minute
+
+// CalculateFreeTimeSlots builds a normalized timeline of [startTime, endTime)
+// from busy slots: overlapping and touching busy slots are merged, busy time
+// outside the range is clipped, and the gaps are returned as free slots. The
+// result is sorted, non-overlapping, alternates between busy and free, and
+// covers the whole range.
+func CalculateFreeTimeSlots(startTime, endTime time.Time, busy []TimeSlot) []TimeSlot {
+	var clipped []TimeSlot
+	for _, slot := range busy {
+		start, end := slot.Start, slot.End
+		if start.Before(startTime) {
+			start = startTime
+		}
+		if end.After(endTime) {
+			end = endTime
+		}
+		if start.Before(end) {
+			clipped = append(clipped, TimeSlot{Start: start, End: end})
+		}
+	}
+
+	merged := MergeBusySlots(clipped)
+	free := FreeSlotsBetween(startTime, endTime, merged)
+	timeline := make([]TimeSlot, 0, len(merged)+len(free))
+
+	// Interleave the two sorted, non-overlapping lists
+	i, j := 0, 0
+	for i < len(merged) || j < len(free) {
+		if j == len(free) || (i < len(merged) && merged[i].Start.Before(free[j].Start)) {
+			timeline = append(timeline, merged[i])
+			i++
+		} else {
+			timeline = append(timeline, free[j])
+			j++
+		}
+	}
+
+	return timeline
+}
+
+// BusySlotsFromEvents returns the busy slots of the events that block time
+// under rules. All-day events are busy from midnight to midnight in loc.
+func BusySlotsFromEvents(events []*calendar.Event, rules BusyRules, loc *time.Location) ([]TimeSlot, error) {
+	var busy []TimeSlot
+	for _, event := range events {
+		if !IsBusyEvent(event, rules) {
+			continue
+		}
+
+		start, _, err := parseEventTime(event.Start, loc)
+		if err != nil {
+			return nil, NewInternalError(ErrCodeInvalidEventData, fmt.Sprintf("Event %s has an invalid start time", event.Id), err)
+		}
+		end, _, err := parseEventTime(event.End, loc)
+		if err != nil {
+			return nil, NewInternalError(ErrCodeInvalidEventData, fmt.Sprintf("Event %s has an invalid end time", event.Id), err)
+		}
+
+		busy = append(busy, TimeSlot{Start: start, End: end})
+	}
+
+	return busy, nil
+}
+
+// parseEventTime parses a Google event time, reporting whether it is an
+// all-day date. All-day dates are taken to start at midnight in loc.
+func parseEventTime(edt *calendar.EventDateTime, loc *time.Location) (time.Time, bool, error) {
+	if edt == nil {
+		return time.Time{}, false, fmt.Errorf("missing event time")
+	}
+
+	if edt.DateTime == "" && edt.Date != "" {
+		date, err := time.ParseInLocation("2006-01-02", edt.Date, loc)
+		return date, true, err
+	}
+
+	dateTime, err := time.Parse(time.RFC3339, edt.DateTime)
+	return dateTime, false, err
+}
+
+// ApplyAvailabilityOptions shapes an availability timeline according to the
+// request: busy events are padded by the buffer, free time outside
+// workingHours (if given) is marked out of hours, free slots shorter than the
+// minimum duration are dropped, and remaining free time is split into
+// fixed-size bookable slots aligned in loc.
+func ApplyAvailabilityOptions(slots []TimeSlot, req *AvailabilityRequest, workingHours *WorkingHours, loc *time.Location) []TimeSlot {
+	if req.Buffer > 0 {
+		slots = padBusySlots(slots, req.Buffer, req.StartTime, req.EndTime)
+	}
+
+	if workingHours != nil {
+		slots = clipToWorkingHours(slots, workingHours.Intervals(req.StartTime, req.EndTime))
+	}
+
+	if req.MinSlotDuration <= 0 && req.SlotSize <= 0 {
+		return slots
+	}
+
+	shaped := []TimeSlot{}
+	for _, slot := range slots {
+		if !slot.Free {
+			shaped = append(shaped, slot)
+			continue
+		}
+		if req.MinSlotDuration > 0 && slot.End.Sub(slot.Start) < req.MinSlotDuration {
+			continue
+		}
+		if req.SlotSize > 0 {
+			shaped = append(shaped, splitIntoSlots(slot, req.SlotSize, loc)...)
+			continue
+		}
+		shaped = append(shaped, slot)
+	}
+
+	return shaped
+}
+
+// padBusySlots widens every busy slot by buffer on both sides, within [startTime, endTime),
+// and rebuilds the timeline so that the free slots shrink accordingly
+func padBusySlots(slots []TimeSlot, buffer time.Duration, startTime, endTime time.Time) []TimeSlot {
+	var busy []TimeSlot
+	for _, slot := range slots {
+		if slot.Free || slot.OutOfHours {
+			continue
+		}
+		busy = append(busy, TimeSlot{Start: slot.Start.Add(-buffer), End: slot.End.Add(buffer)})
+	}
+
+	return CalculateFreeTimeSlots(startTime, endTime, busy)
+}
+
+// splitIntoSlots splits a free slot into consecutive slots of the given size,
+// starting on multiples of size after local midnight. Leftover time is dropped.
+func splitIntoSlots(free TimeSlot, size time.Duration, loc *time.Location) []TimeSlot {
+	local := free.Start.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	offset := free.Start.Sub(midnight)
+	start := midnight.Add((offset + size - 1) / size * size)
+
+	var slots []TimeSlot
+	for ; !start.Add(size).After(free.End); start = start.Add(size) {
+		slots = append(slots, TimeSlot{Start: start, End: start.Add(size), Free: true})
+	}
+	return slots
+}
+
+// IsBusyEvent reports whether an event blocks time under the given rules.
+// Cancelled events and working location events never do.
+func IsBusyEvent(event *calendar.Event, rules BusyRules) bool {
+	if event.Status == EventStatusCancelled || event.EventType == EventTypeWorkingLocation {
+		return false
+	}
+
+	if event.Transparency == EventTransparencyTransparent && !rules.CountTransparent {
+		return false
+	}
+
+	response := selfResponseStatus(event)
+	if response == ResponseStatusDeclined && !rules.CountDeclined {
+		return false
+	}
+
+	if rules.TentativeAsFree && (response == ResponseStatusTentative || event.Status == EventStatusTentative) {
+		return false
+	}
+
+	return true
+}
+
+// validateAvailabilityRequest validates an availability request
+func validateAvailabilityRequest(req *AvailabilityRequest) error {
+	if req.StartTime.After(req.EndTime) {
+		return NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+
+	if req.Buffer < 0 || req.MinSlotDuration < 0 {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Buffer and minimum slot duration cannot be negative", "")
+	}
+
+	if req.SlotSize != 0 && (req.SlotSize < MinAvailabilitySlotSize || req.SlotSize > 24*time.Hour) {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Slot size must be between 5 minutes and 24 hours", "")
+	}
+
+	return nil
+}
@@ -0,0 +1,393 @@
+package calendar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// loadAppointmentTypes reads appointment types from inline JSON or a JSON file path
+func loadAppointmentTypes(value string) (map[string]*AppointmentType, error) {
+	data := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "{") {
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read appointment types file: %w", err)
+		}
+		data = content
+	}
+
+	var appointmentTypes map[string]*AppointmentType
+	if err := json.Unmarshal(data, &appointmentTypes); err != nil {
+		return nil, fmt.Errorf("invalid appointment types JSON: %w", err)
+	}
+
+	return appointmentTypes, nil
+}
+
+// validateAppointmentType checks an appointment type's limits and windows
+func validateAppointmentType(config *CalendarConfig, appointment *AppointmentType) error {
+	if appointment == nil {
+		return fmt.Errorf("definition is empty")
+	}
+	if appointment.DurationMinutes <= 0 || appointment.DurationMinutes > 24*60 {
+		return fmt.Errorf("duration_minutes must be between 1 and 1440")
+	}
+	if appointment.BufferBeforeMinutes < 0 || appointment.BufferAfterMinutes < 0 || appointment.MaxPerDay < 0 ||
+		appointment.MinNoticeMinutes < 0 || appointment.MaxDaysAhead < 0 || appointment.SlotStepMinutes < 0 {
+		return fmt.Errorf("buffers and limits cannot be negative")
+	}
+	if _, err := config.AppointmentWindows(appointment); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AppointmentWindows returns the times an appointment type can be booked:
+// its own windows, or the calendar owner's working hours if it has none
+func (c *CalendarConfig) AppointmentWindows(appointment *AppointmentType) (*WorkingHours, error) {
+	if len(appointment.Windows) == 0 {
+		return c.WorkingHoursFor(c.CalendarID)
+	}
+	return ResolveWorkingHours(&WorkingHoursSpec{TimeZone: appointment.TimeZone, Days: appointment.Windows}, c.TimeZone)
+}
+
+// appointmentType looks up a configured appointment type by name
+func (s *googleCalendarService) appointmentType(name string) (*AppointmentType, error) {
+	if appointment, ok := s.config.AppointmentTypes[name]; ok {
+		return appointment, nil
+	}
+
+	names := make([]string, 0, len(s.config.AppointmentTypes))
+	for configured := range s.config.AppointmentTypes {
+		names = append(names, configured)
+	}
+	sort.Strings(names)
+
+	details := "No appointment types are configured. Set GOOGLE_CALENDAR_APPOINTMENT_TYPES"
+	if len(names) > 0 {
+		details = fmt.Sprintf("Available appointment types: %s", strings.Join(names, ", "))
+	}
+	return nil, NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Unknown appointment type: %s", name), details)
+}
+
+// ListBookableSlots returns the slots of an appointment type that can be
+// booked now. A zero range defaults to now until the booking horizon.
+func (s *googleCalendarService) ListBookableSlots(ctx context.Context, appointmentType string, startTime, endTime time.Time) ([]TimeSlot, error) {
+	appointment, err := s.appointmentType(appointmentType)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if startTime.IsZero() {
+		startTime = now
+	}
+	if endTime.IsZero() {
+		daysAhead := appointment.MaxDaysAhead
+		if daysAhead == 0 {
+			daysAhead = DefaultBookingDaysAhead
+		}
+		endTime = now.AddDate(0, 0, daysAhead)
+	}
+	if !startTime.Before(endTime) {
+		return nil, NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.bookableSlots(ctx, service, appointmentType, appointment, startTime, endTime, now)
+}
+
+// BookSlot books an appointment slot for an external attendee. Availability
+// is re-checked before the event is created, and again afterwards: if a
+// competing booking or event slipped in meanwhile, the new event is deleted
+// and a conflict error is returned so the caller can pick another slot.
+func (s *googleCalendarService) BookSlot(ctx context.Context, req *BookingRequest) (*Event, error) {
+	appointment, err := s.appointmentType(req.AppointmentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if email := strings.TrimSpace(req.AttendeeEmail); email == "" || !strings.Contains(email, "@") {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "A valid attendee email is required", "")
+	}
+
+	// Bookings through this server are serialised; the post-insert check covers other writers
+	s.bookingMu.Lock()
+	defer s.bookingMu.Unlock()
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(appointment.DurationMinutes) * time.Minute
+	endTime := req.StartTime.Add(duration)
+
+	slots, err := s.bookableSlots(ctx, service, req.AppointmentType, appointment, req.StartTime, endTime, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 || !slots[0].Start.Equal(req.StartTime) {
+		return nil, NewConflictError(ErrCodeEventConflict,
+			fmt.Sprintf("The %s slot at %s is not available. Use list_bookable_slots to find another", req.AppointmentType, req.StartTime.Format(time.RFC3339)))
+	}
+
+	attendeeName := strings.TrimSpace(req.AttendeeName)
+	if attendeeName == "" {
+		attendeeName = req.AttendeeEmail
+	}
+
+	description := appointment.Description
+	if req.Notes != "" {
+		description = strings.TrimSpace(description + "\n\n" + req.Notes)
+	}
+
+	googleEvent := &calendar.Event{
+		Summary:     fmt.Sprintf("%s with %s", appointment.Title, attendeeName),
+		Description: description,
+		Location:    appointment.Location,
+		Start:       s.eventDateTime(req.StartTime),
+		End:         s.eventDateTime(endTime),
+		Attendees: []*calendar.EventAttendee{
+			{Email: strings.TrimSpace(req.AttendeeEmail), DisplayName: strings.TrimSpace(req.AttendeeName)},
+		},
+		ExtendedProperties: &calendar.EventExtendedProperties{
+			Private: map[string]string{AppointmentTypePropertyKey: req.AppointmentType},
+		},
+	}
+
+	created, err := service.Events.Insert(s.config.CalendarID, googleEvent).
+		SendUpdates("all").
+		Context(ctx).
+		Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to create event")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to book slot", err)
+	}
+
+	if err := s.verifyBooking(ctx, service, created, appointment); err != nil {
+		if deleteErr := service.Events.Delete(s.config.CalendarID, created.Id).SendUpdates("all").Context(ctx).Do(); deleteErr != nil {
+			log.Printf("Warning: Failed to roll back booking %s: %v", created.Id, deleteErr)
+		}
+		return nil, err
+	}
+
+	log.Printf("Booked %s for %s at %s", req.AppointmentType, req.AttendeeEmail, req.StartTime.Format(time.RFC3339))
+	return s.convertGoogleEventToEvent(created), nil
+}
+
+// bookableSlots lists the calendar's events around [startTime, endTime) and
+// computes the bookable slots of an appointment type within it
+func (s *googleCalendarService) bookableSlots(ctx context.Context, service *calendar.Service, name string,
+	appointment *AppointmentType, startTime, endTime, now time.Time) ([]TimeSlot, error) {
+	windows, err := s.config.AppointmentWindows(appointment)
+	if err != nil {
+		return nil, NewInvalidInputError(ErrCodeConfigurationError, "Invalid appointment windows", err.Error())
+	}
+
+	// Whole days are needed for the daily cap, and the buffers reach past the range
+	listStart, listEnd := bookingDays(startTime, endTime, windows.Location)
+	events, err := s.listEventsBetween(ctx, service, listStart, listEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	busy, err := BusySlotsFromEvents(events, BusyRules{}, s.location())
+	if err != nil {
+		return nil, err
+	}
+
+	var booked []TimeSlot
+	for _, event := range events {
+		if isBookingOf(event, name) && IsBusyEvent(event, BusyRules{}) {
+			start, _ := s.parseEventDateTime(event.Start)
+			booked = append(booked, TimeSlot{Start: start})
+		}
+	}
+
+	return BookableSlots(appointment, windows, busy, booked, startTime, endTime, now), nil
+}
+
+// verifyBooking re-checks a just-created booking against the calendar. A
+// booking loses to any other busy event within its buffers, except bookings
+// created after it, and to earlier bookings that fill the day's cap.
+func (s *googleCalendarService) verifyBooking(ctx context.Context, service *calendar.Service, booking *calendar.Event, appointment *AppointmentType) error {
+	name := booking.ExtendedProperties.Private[AppointmentTypePropertyKey]
+	windows, err := s.config.AppointmentWindows(appointment)
+	if err != nil {
+		return NewInvalidInputError(ErrCodeConfigurationError, "Invalid appointment windows", err.Error())
+	}
+
+	start, _ := s.parseEventDateTime(booking.Start)
+	end, _ := s.parseEventDateTime(booking.End)
+	padded := TimeSlot{
+		Start: start.Add(-time.Duration(appointment.BufferBeforeMinutes) * time.Minute),
+		End:   end.Add(time.Duration(appointment.BufferAfterMinutes) * time.Minute),
+	}
+
+	listStart, listEnd := bookingDays(start, end, windows.Location)
+	events, err := s.listEventsBetween(ctx, service, listStart, listEnd)
+	if err != nil {
+		return err
+	}
+
+	day := start.In(windows.Location).Format("2006-01-02")
+	earlierBookings := 0
+	for _, event := range events {
+		if event.Id == booking.Id || !IsBusyEvent(event, BusyRules{}) {
+			continue
+		}
+		_, isBooking := bookingProperty(event)
+		if isBooking && !createdBefore(event, booking) {
+			continue
+		}
+
+		busy, err := BusySlotsFromEvents([]*calendar.Event{event}, BusyRules{}, s.location())
+		if err != nil {
+			return err
+		}
+		for _, slot := range busy {
+			if slot.Start.Before(padded.End) && slot.End.After(padded.Start) {
+				return NewConflictError(ErrCodeEventConflict,
+					fmt.Sprintf("The %s slot at %s was taken while booking. Use list_bookable_slots to find another", name, start.Format(time.RFC3339)))
+			}
+		}
+
+		eventStart, _ := s.parseEventDateTime(event.Start)
+		if isBookingOf(event, name) && eventStart.In(windows.Location).Format("2006-01-02") == day {
+			earlierBookings++
+		}
+	}
+
+	if appointment.MaxPerDay > 0 && earlierBookings >= appointment.MaxPerDay {
+		return NewConflictError(ErrCodeEventConflict,
+			fmt.Sprintf("The daily limit of %d %s bookings was reached while booking", appointment.MaxPerDay, name))
+	}
+
+	return nil
+}
+
+// listEventsBetween returns the configured calendar's event instances in [startTime, endTime)
+func (s *googleCalendarService) listEventsBetween(ctx context.Context, service *calendar.Service, startTime, endTime time.Time) ([]*calendar.Event, error) {
+	var events []*calendar.Event
+	err := service.Events.List(s.config.CalendarID).
+		TimeMin(startTime.Format(time.RFC3339)).
+		TimeMax(endTime.Format(time.RFC3339)).
+		SingleEvents(true).
+		OrderBy("startTime").
+		MaxResults(2500).
+		Pages(ctx, func(page *calendar.Events) error {
+			events = append(events, page.Items...)
+			return nil
+		})
+	if err != nil {
+		return nil, mapCalendarError(err, s.config.CalendarID, "retrieve events from")
+	}
+	return events, nil
+}
+
+// BookableSlots returns the slots of an appointment type that can be booked
+// in [startTime, endTime) at time now. Slots lie within the windows, start
+// on multiples of the slot step after local midnight, keep the buffers clear
+// of busy time, and skip days on which the booked appointments of this type
+// already reach the daily cap.
+func BookableSlots(appointment *AppointmentType, windows *WorkingHours, busy, booked []TimeSlot, startTime, endTime, now time.Time) []TimeSlot {
+	duration := time.Duration(appointment.DurationMinutes) * time.Minute
+	step := time.Duration(appointment.SlotStepMinutes) * time.Minute
+	if step == 0 {
+		step = duration
+	}
+	before := time.Duration(appointment.BufferBeforeMinutes) * time.Minute
+	after := time.Duration(appointment.BufferAfterMinutes) * time.Minute
+
+	if earliest := now.Add(time.Duration(appointment.MinNoticeMinutes) * time.Minute); startTime.Before(earliest) {
+		startTime = earliest
+	}
+	if appointment.MaxDaysAhead > 0 {
+		if latest := now.AddDate(0, 0, appointment.MaxDaysAhead); endTime.After(latest) {
+			endTime = latest
+		}
+	}
+
+	slots := []TimeSlot{}
+	if !startTime.Before(endTime) || duration <= 0 {
+		return slots
+	}
+
+	perDay := make(map[string]int)
+	for _, booking := range booked {
+		perDay[booking.Start.In(windows.Location).Format("2006-01-02")]++
+	}
+
+	// Widen busy blocks so that a free gap leaves room for the buffers, and
+	// treat time outside the windows as busy without buffers
+	var blocked []TimeSlot
+	for _, block := range busy {
+		blocked = append(blocked, TimeSlot{Start: block.Start.Add(-after), End: block.End.Add(before)})
+	}
+	blocked = append(blocked, FreeSlotsBetween(startTime, endTime, windows.Intervals(startTime, endTime))...)
+
+	for _, free := range FreeSlotsBetween(startTime, endTime, blocked) {
+		local := free.Start.In(windows.Location)
+		midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, windows.Location)
+		start := midnight.Add((free.Start.Sub(midnight) + step - 1) / step * step)
+
+		for ; !start.Add(duration).After(free.End); start = start.Add(step) {
+			if appointment.MaxPerDay > 0 && perDay[start.In(windows.Location).Format("2006-01-02")] >= appointment.MaxPerDay {
+				continue
+			}
+			slots = append(slots, TimeSlot{Start: start, End: start.Add(duration), Free: true})
+		}
+	}
+
+	return slots
+}
+
+// bookingDays widens [startTime, endTime) to whole days in loc, plus a day
+// either side so buffers and bookings just outside the range are seen
+func bookingDays(startTime, endTime time.Time, loc *time.Location) (time.Time, time.Time) {
+	first := startTime.In(loc).AddDate(0, 0, -1)
+	last := endTime.In(loc).AddDate(0, 0, 1)
+	return time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc),
+		time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+}
+
+// bookingProperty returns the appointment type an event was booked as, if any
+func bookingProperty(event *calendar.Event) (string, bool) {
+	if event.ExtendedProperties == nil {
+		return "", false
+	}
+	name, ok := event.ExtendedProperties.Private[AppointmentTypePropertyKey]
+	return name, ok
+}
+
+// isBookingOf reports whether an event is a booking of the named appointment type
+func isBookingOf(event *calendar.Event, name string) bool {
+	booked, ok := bookingProperty(event)
+	return ok && booked == name
+}
+
+// createdBefore reports whether event a was created before event b, using
+// the event ID to break ties so that exactly one of two bookings wins
+func createdBefore(a, b *calendar.Event) bool {
+	aCreated, _ := time.Parse(time.RFC3339, a.Created)
+	bCreated, _ := time.Parse(time.RFC3339, b.Created)
+	if !aCreated.Equal(bCreated) {
+		return aCreated.Before(bCreated)
+	}
+	return a.Id < b.Id
+}
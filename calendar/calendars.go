@@ -0,0 +1,221 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// ListCalendars retrieves the calendars in the user's calendar list
+func (s *googleCalendarService) ListCalendars(ctx context.Context, showHidden bool) ([]*CalendarEntry, error) {
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendars []*CalendarEntry
+	err = service.CalendarList.List().
+		ShowHidden(showHidden).
+		Pages(ctx, func(page *calendar.CalendarList) error {
+			for _, entry := range page.Items {
+				calendars = append(calendars, convertCalendarListEntry(entry))
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to list calendars", err)
+	}
+
+	return calendars, nil
+}
+
+// CreateCalendar creates a new secondary calendar
+func (s *googleCalendarService) CreateCalendar(ctx context.Context, req *CalendarCreateRequest) (*CalendarInfo, error) {
+	if strings.TrimSpace(req.Summary) == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Calendar summary is required", "")
+	}
+
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = s.config.TimeZone
+	}
+	if err := validateTimeZone(timeZone); err != nil {
+		return nil, err
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := service.Calendars.Insert(&calendar.Calendar{
+		Summary:     req.Summary,
+		Description: req.Description,
+		TimeZone:    timeZone,
+		Location:    req.Location,
+	}).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to create calendar")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to create calendar", err)
+	}
+
+	log.Printf("Successfully created calendar: %s", created.Id)
+	return convertCalendarToInfo(created), nil
+}
+
+// UpdateCalendar updates the metadata of a calendar
+func (s *googleCalendarService) UpdateCalendar(ctx context.Context, calendarID string, update *CalendarUpdateRequest) (*CalendarInfo, error) {
+	if calendarID == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Calendar ID is required", "")
+	}
+
+	patch := &calendar.Calendar{}
+	if update.Summary != nil {
+		if strings.TrimSpace(*update.Summary) == "" {
+			return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Calendar summary cannot be empty", "")
+		}
+		patch.Summary = *update.Summary
+	}
+	if update.Description != nil {
+		patch.Description = *update.Description
+		patch.ForceSendFields = append(patch.ForceSendFields, "Description")
+	}
+	if update.TimeZone != nil {
+		if err := validateTimeZone(*update.TimeZone); err != nil {
+			return nil, err
+		}
+		patch.TimeZone = *update.TimeZone
+	}
+	if update.Location != nil {
+		patch.Location = *update.Location
+		patch.ForceSendFields = append(patch.ForceSendFields, "Location")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := service.Calendars.Patch(calendarID, patch).Context(ctx).Do()
+	if err != nil {
+		return nil, mapCalendarError(err, calendarID, "update")
+	}
+
+	return convertCalendarToInfo(updated), nil
+}
+
+// DeleteCalendar permanently deletes a secondary calendar
+func (s *googleCalendarService) DeleteCalendar(ctx context.Context, calendarID string) error {
+	if calendarID == "" {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Calendar ID is required", "")
+	}
+
+	if calendarID == DefaultCalendarID {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "The primary calendar cannot be deleted", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Calendars.Delete(calendarID).Context(ctx).Do(); err != nil {
+		return mapCalendarError(err, calendarID, "delete")
+	}
+
+	log.Printf("Successfully deleted calendar: %s", calendarID)
+	return nil
+}
+
+// SubscribeCalendar adds an existing calendar to the user's calendar list
+func (s *googleCalendarService) SubscribeCalendar(ctx context.Context, calendarID string) (*CalendarEntry, error) {
+	if calendarID == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Calendar ID is required", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := service.CalendarList.Insert(&calendar.CalendarListEntry{Id: calendarID}).Context(ctx).Do()
+	if err != nil {
+		return nil, mapCalendarError(err, calendarID, "subscribe to")
+	}
+
+	return convertCalendarListEntry(entry), nil
+}
+
+// UnsubscribeCalendar removes a calendar from the user's calendar list
+// without deleting the calendar itself
+func (s *googleCalendarService) UnsubscribeCalendar(ctx context.Context, calendarID string) error {
+	if calendarID == "" {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Calendar ID is required", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := service.CalendarList.Delete(calendarID).Context(ctx).Do(); err != nil {
+		return mapCalendarError(err, calendarID, "unsubscribe from")
+	}
+
+	return nil
+}
+
+// mapCalendarError converts a Google API error for a calendar operation into a CalendarError
+func mapCalendarError(err error, calendarID, action string) error {
+	if strings.Contains(err.Error(), "notFound") {
+		return NewNotFoundError(ErrCodeCalendarNotFound, fmt.Sprintf("Calendar not found: %s", calendarID))
+	}
+	if strings.Contains(err.Error(), "forbidden") {
+		return NewPermissionError(ErrCodePermissionDenied, fmt.Sprintf("Permission denied to %s calendar %s", action, calendarID))
+	}
+	return NewInternalError(ErrCodeServiceUnavailable, fmt.Sprintf("Failed to %s calendar", action), err)
+}
+
+// validateTimeZone validates an IANA timezone name
+func validateTimeZone(timeZone string) error {
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid timezone: %s", timeZone),
+			"Timezone must be an IANA timezone name such as Europe/London")
+	}
+	return nil
+}
+
+// convertCalendarListEntry converts a Google calendar list entry to our CalendarEntry struct
+func convertCalendarListEntry(entry *calendar.CalendarListEntry) *CalendarEntry {
+	return &CalendarEntry{
+		ID:              entry.Id,
+		Summary:         entry.Summary,
+		SummaryOverride: entry.SummaryOverride,
+		Description:     entry.Description,
+		TimeZone:        entry.TimeZone,
+		AccessRole:      entry.AccessRole,
+		ColorID:         entry.ColorId,
+		BackgroundColor: entry.BackgroundColor,
+		ForegroundColor: entry.ForegroundColor,
+		Primary:         entry.Primary,
+		Hidden:          entry.Hidden,
+		Selected:        entry.Selected,
+	}
+}
+
+// convertCalendarToInfo converts a Google calendar to our CalendarInfo struct
+func convertCalendarToInfo(cal *calendar.Calendar) *CalendarInfo {
+	return &CalendarInfo{
+		ID:          cal.Id,
+		Summary:     cal.Summary,
+		Description: cal.Description,
+		TimeZone:    cal.TimeZone,
+		Location:    cal.Location,
+	}
+}
@@ -18,6 +18,35 @@ func LoadConfig() (*CalendarConfig, error) {
 		LogLevel:        getEnvWithDefault("LOG_LEVEL", "info"),
 		Environment:     getEnvWithDefault("ENVIRONMENT", "development"),
 		Debug:           getEnvBool("DEBUG", false),
+		DirectoryAdmin:  getEnvWithDefault("GOOGLE_CALENDAR_DIRECTORY_ADMIN", ""),
+	}
+	config.TimeZoneExplicit = os.Getenv("GOOGLE_CALENDAR_TIMEZONE") != ""
+
+	// Working hours may be given as inline JSON or as a path to a JSON file
+	if workingHours := os.Getenv("GOOGLE_CALENDAR_WORKING_HOURS"); workingHours != "" {
+		parsed, err := loadWorkingHoursConfig(workingHours)
+		if err != nil {
+			return nil, NewConfigurationError(ErrCodeConfigurationError, "Invalid working hours", err)
+		}
+		config.WorkingHours = parsed
+	}
+
+	// Rooms may be given as inline JSON or as a path to a JSON file
+	if rooms := os.Getenv("GOOGLE_CALENDAR_ROOMS"); rooms != "" {
+		parsed, err := loadRoomCatalog(rooms)
+		if err != nil {
+			return nil, NewConfigurationError(ErrCodeConfigurationError, "Invalid room catalog", err)
+		}
+		config.Rooms = parsed
+	}
+
+	// Appointment types may be given as inline JSON or as a path to a JSON file
+	if appointmentTypes := os.Getenv("GOOGLE_CALENDAR_APPOINTMENT_TYPES"); appointmentTypes != "" {
+		parsed, err := loadAppointmentTypes(appointmentTypes)
+		if err != nil {
+			return nil, NewConfigurationError(ErrCodeConfigurationError, "Invalid appointment types", err)
+		}
+		config.AppointmentTypes = parsed
 	}
 
 	if err := validateConfig(config); err != nil {
@@ -71,6 +100,25 @@ func validateConfig(config *CalendarConfig) error {
 		errors = append(errors, "Timezone cannot be empty")
 	}
 
+	// Validate working hours
+	if config.WorkingHours != nil {
+		if _, err := config.WorkingHoursFor(config.CalendarID); err != nil {
+			errors = append(errors, fmt.Sprintf("Invalid working hours: %v", err))
+		}
+		for person := range config.WorkingHours.People {
+			if _, err := config.WorkingHoursFor(person); err != nil {
+				errors = append(errors, fmt.Sprintf("Invalid working hours: %v", err))
+			}
+		}
+	}
+
+	// Validate appointment types
+	for name, appointment := range config.AppointmentTypes {
+		if err := validateAppointmentType(config, appointment); err != nil {
+			errors = append(errors, fmt.Sprintf("Invalid appointment type %s: %v", name, err))
+		}
+	}
+
 	// Validate log level
 	validLogLevels := map[string]bool{
 		"debug": true,
@@ -0,0 +1,409 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// checkEventConflicts returns a conflict error listing the busy time that
+// [startTime, endTime) overlaps on the configured calendar, the organizer's
+// calendar and the attendees' calendars. organizer is nil for new events,
+// which the configured calendar organizes. moved is the event being updated,
+// if any, which never conflicts with itself.
+func (s *googleCalendarService) checkEventConflicts(ctx context.Context, service *calendar.Service, startTime, endTime time.Time,
+	attendees []string, organizer *calendar.EventOrganizer, moved *MovedEvent) error {
+	var events []*calendar.Event
+	err := service.Events.List(s.config.CalendarID).
+		TimeMin(startTime.Format(time.RFC3339)).
+		TimeMax(endTime.Format(time.RFC3339)).
+		SingleEvents(true).
+		OrderBy("startTime").
+		MaxResults(2500).
+		Pages(ctx, func(page *calendar.Events) error {
+			events = append(events, page.Items...)
+			return nil
+		})
+	if err != nil {
+		return mapCalendarError(err, s.config.CalendarID, "check conflicts on")
+	}
+
+	var excludeEventID string
+	if moved != nil {
+		excludeEventID = moved.EventID
+	}
+	conflicts, err := OverlappingEvents(s.config.CalendarID, startTime, endTime, events, excludeEventID, s.location())
+	if err != nil {
+		return err
+	}
+
+	// The configured calendar was read above; any other organizer, such as
+	// when editing an invitation, is checked with the attendees
+	if organizer != nil && !organizer.Self && organizer.Email != "" {
+		attendees = append(append([]string{}, attendees...), organizer.Email)
+	}
+
+	var others []string
+	for _, attendee := range uniqueStrings(attendees) {
+		if !strings.EqualFold(attendee, s.config.CalendarID) {
+			others = append(others, attendee)
+		}
+	}
+
+	if len(others) > 0 {
+		freeBusy, err := s.QueryFreeBusy(ctx, &FreeBusyRequest{StartTime: startTime, EndTime: endTime, CalendarIDs: others})
+		if err != nil {
+			return err
+		}
+		if moved != nil {
+			if err := s.excludeMovedEvent(ctx, service, freeBusy, moved); err != nil {
+				return err
+			}
+		}
+		conflicts = append(conflicts, overlappingBusy(freeBusy, startTime, endTime)...)
+	}
+
+	if len(conflicts) > 0 {
+		return newEventConflictError(conflicts)
+	}
+	return nil
+}
+
+// excludeMovedEvent removes a moved event's own busy time from free/busy
+// results. The calendars that had the event are read where permitted, so the
+// event can be skipped by ID; the others keep their free/busy.
+func (s *googleCalendarService) excludeMovedEvent(ctx context.Context, service *calendar.Service, freeBusy *FreeBusyResult, moved *MovedEvent) error {
+	calendarEvents := make(map[string][]*calendar.Event)
+	for _, cal := range freeBusy.Calendars {
+		if len(cal.Errors) > 0 || !containsFold(moved.Calendars, cal.CalendarID) {
+			continue
+		}
+
+		var events []*calendar.Event
+		err := service.Events.List(cal.CalendarID).
+			TimeMin(freeBusy.StartTime.Format(time.RFC3339)).
+			TimeMax(freeBusy.EndTime.Format(time.RFC3339)).
+			SingleEvents(true).
+			MaxResults(2500).
+			Pages(ctx, func(page *calendar.Events) error {
+				events = append(events, page.Items...)
+				return nil
+			})
+		if err != nil {
+			// Only free/busy is shared with us, so fall back to it
+			continue
+		}
+		calendarEvents[cal.CalendarID] = events
+	}
+
+	return ExcludeMovedEvent(freeBusy, moved, calendarEvents, s.location())
+}
+
+// ExcludeMovedEvent removes a moved event's own busy time from free/busy
+// results, in place. calendarEvents holds the events of the calendars that
+// could be read: their busy time is rebuilt from every event but the moved
+// one. Other calendars that had the event only expose merged busy blocks, so
+// its previous time is subtracted from them, which can also hide other busy
+// time in that slot. Calendars that did not have the event are unchanged.
+func ExcludeMovedEvent(freeBusy *FreeBusyResult, moved *MovedEvent, calendarEvents map[string][]*calendar.Event, loc *time.Location) error {
+	var allBusy []TimeSlot
+	for i, cal := range freeBusy.Calendars {
+		if len(cal.Errors) > 0 {
+			continue
+		}
+
+		if containsFold(moved.Calendars, cal.CalendarID) {
+			if events, ok := calendarEvents[cal.CalendarID]; ok {
+				var others []*calendar.Event
+				for _, event := range events {
+					if event.Id != moved.EventID && event.RecurringEventId != moved.EventID {
+						others = append(others, event)
+					}
+				}
+				busy, err := BusySlotsFromEvents(others, BusyRules{}, loc)
+				if err != nil {
+					return err
+				}
+				// Merge like Google's free/busy, so blocks are counted the same on every calendar
+				freeBusy.Calendars[i].Busy = append([]TimeSlot{}, MergeBusySlots(busy)...)
+			} else {
+				freeBusy.Calendars[i].Busy = append([]TimeSlot{}, subtractTimeSlot(MergeBusySlots(cal.Busy), moved.Previous)...)
+			}
+		}
+		allBusy = append(allBusy, freeBusy.Calendars[i].Busy...)
+	}
+
+	freeBusy.CommonFree = FreeSlotsBetween(freeBusy.StartTime, freeBusy.EndTime, allBusy)
+	return nil
+}
+
+// movedEventCalendars returns the calendars on which an event blocks its
+// current time: the calendar it is on, its organizer's and those of attendees
+// who have not declined. An event shown as free blocks none.
+func movedEventCalendars(calendarID string, event *calendar.Event) []string {
+	if event.Transparency == EventTransparencyTransparent {
+		return nil
+	}
+
+	calendars := []string{calendarID}
+	if event.Organizer != nil && event.Organizer.Email != "" {
+		calendars = append(calendars, event.Organizer.Email)
+	}
+	for _, attendee := range event.Attendees {
+		if attendee.Email != "" && attendee.ResponseStatus != ResponseStatusDeclined {
+			calendars = append(calendars, attendee.Email)
+		}
+	}
+	return uniqueStrings(calendars)
+}
+
+// OverlappingEvents returns the events on a calendar that block time under
+// Google's busy semantics and overlap [startTime, endTime). The event with ID
+// excludeEventID, and its recurring instances, are skipped.
+func OverlappingEvents(calendarID string, startTime, endTime time.Time, events []*calendar.Event, excludeEventID string, loc *time.Location) ([]EventConflict, error) {
+	var conflicts []EventConflict
+	for _, event := range events {
+		if excludeEventID != "" && (event.Id == excludeEventID || event.RecurringEventId == excludeEventID) {
+			continue
+		}
+
+		busy, err := BusySlotsFromEvents([]*calendar.Event{event}, BusyRules{}, loc)
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range busy {
+			if slot.Start.Before(endTime) && slot.End.After(startTime) {
+				conflicts = append(conflicts, EventConflict{
+					CalendarID: calendarID,
+					EventID:    event.Id,
+					Summary:    event.Summary,
+					Start:      slot.Start,
+					End:        slot.End,
+				})
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// overlappingBusy returns the busy blocks overlapping [startTime, endTime) on
+// calendars whose availability is known
+func overlappingBusy(freeBusy *FreeBusyResult, startTime, endTime time.Time) []EventConflict {
+	var conflicts []EventConflict
+	for _, cal := range freeBusy.Calendars {
+		if len(cal.Errors) > 0 {
+			continue
+		}
+
+		for _, slot := range MergeBusySlots(cal.Busy) {
+			if slot.Start.Before(endTime) && slot.End.After(startTime) {
+				conflicts = append(conflicts, EventConflict{CalendarID: cal.CalendarID, Start: slot.Start, End: slot.End})
+			}
+		}
+	}
+	return conflicts
+}
+
+// subtractTimeSlot removes gap from each slot, splitting slots it falls inside
+func subtractTimeSlot(slots []TimeSlot, gap TimeSlot) []TimeSlot {
+	var result []TimeSlot
+	for _, slot := range slots {
+		if !gap.End.After(slot.Start) || !gap.Start.Before(slot.End) {
+			result = append(result, slot)
+			continue
+		}
+		if slot.Start.Before(gap.Start) {
+			result = append(result, TimeSlot{Start: slot.Start, End: gap.Start})
+		}
+		if gap.End.Before(slot.End) {
+			result = append(result, TimeSlot{Start: gap.End, End: slot.End})
+		}
+	}
+	return result
+}
+
+// newEventConflictError builds a conflict error that lists the clashes
+func newEventConflictError(conflicts []EventConflict) CalendarError {
+	clashes := make([]string, len(conflicts))
+	for i, conflict := range conflicts {
+		clashes[i] = conflict.String()
+	}
+	return NewConflictError(ErrCodeEventConflict,
+		fmt.Sprintf("Event overlaps existing busy time: %s. Set allow_conflicts to book it anyway", strings.Join(clashes, "; ")))
+}
+
+// String describes the conflict, e.g. "bob@example.com busy 2024-01-15T10:00:00Z to 2024-01-15T11:00:00Z"
+func (c EventConflict) String() string {
+	what := "busy"
+	if c.EventID != "" {
+		what = fmt.Sprintf("%q (%s)", c.Summary, c.EventID)
+	}
+	return fmt.Sprintf("%s %s %s to %s", c.CalendarID, what, c.Start.Format(time.RFC3339), c.End.Format(time.RFC3339))
+}
+
+// FindConflicts audits each calendar in the range for overlapping accepted
+// events, long chains of back-to-back events and events outside working
+// hours. Calendars that cannot be read are reported with an error.
+func (s *googleCalendarService) FindConflicts(ctx context.Context, req *ConflictReportRequest) (*ConflictReport, error) {
+	if !req.StartTime.Before(req.EndTime) {
+		return nil, NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+	if req.MaxChain < 0 || req.MinBreak < 0 {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Maximum chain length and minimum break cannot be negative", "")
+	}
+
+	calendarIDs := uniqueStrings(req.CalendarIDs)
+	if len(calendarIDs) == 0 {
+		calendarIDs = []string{s.config.CalendarID}
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ConflictReport{StartTime: req.StartTime, EndTime: req.EndTime, Calendars: []CalendarConflictReport{}}
+	for _, calendarID := range calendarIDs {
+		workingHours, err := s.config.WorkingHoursFor(calendarID)
+		if err != nil {
+			return nil, NewInvalidInputError(ErrCodeConfigurationError, "Invalid working hours", err.Error())
+		}
+
+		var events []*calendar.Event
+		err = service.Events.List(calendarID).
+			TimeMin(req.StartTime.Format(time.RFC3339)).
+			TimeMax(req.EndTime.Format(time.RFC3339)).
+			SingleEvents(true).
+			OrderBy("startTime").
+			MaxResults(2500).
+			Pages(ctx, func(page *calendar.Events) error {
+				events = append(events, page.Items...)
+				return nil
+			})
+		if err != nil {
+			report.Calendars = append(report.Calendars, CalendarConflictReport{
+				CalendarID: calendarID,
+				Error:      mapCalendarError(err, calendarID, "read").Error(),
+			})
+			continue
+		}
+
+		audit, err := AuditEvents(calendarID, calendarID == s.config.CalendarID, events, workingHours, req)
+		if err != nil {
+			return nil, err
+		}
+		report.Calendars = append(report.Calendars, *audit)
+	}
+
+	return report, nil
+}
+
+// AuditEvents finds the overlapping pairs, long back-to-back chains and out
+// of hours events among a calendar's accepted, timed events. Events are
+// accepted if they block time and the calendar owner organised or accepted
+// them; all-day events are not audited. own reports whether calendarID is the
+// configured calendar, whose owner is the attendee marked as self. Zero
+// MaxChain and MinBreak in req use the defaults.
+func AuditEvents(calendarID string, own bool, events []*calendar.Event, workingHours *WorkingHours, req *ConflictReportRequest) (*CalendarConflictReport, error) {
+	maxChain, minBreak := req.MaxChain, req.MinBreak
+	if maxChain == 0 {
+		maxChain = DefaultMaxChain
+	}
+	if minBreak == 0 {
+		minBreak = DefaultMinBreak
+	}
+
+	var accepted []AuditedEvent
+	for _, event := range events {
+		// Our own response says nothing about a colleague's, so it is not used to judge busyness here
+		response := ownerResponseStatus(event, calendarID, own)
+		if !IsBusyEvent(event, BusyRules{CountDeclined: true}) || (response != "" && response != ResponseStatusAccepted) {
+			continue
+		}
+
+		start, allDay, err := parseEventTime(event.Start, workingHours.Location)
+		if err != nil {
+			return nil, NewInternalError(ErrCodeInvalidEventData, fmt.Sprintf("Event %s has an invalid start time", event.Id), err)
+		}
+		end, _, err := parseEventTime(event.End, workingHours.Location)
+		if err != nil {
+			return nil, NewInternalError(ErrCodeInvalidEventData, fmt.Sprintf("Event %s has an invalid end time", event.Id), err)
+		}
+		if allDay || !start.Before(end) {
+			continue
+		}
+
+		accepted = append(accepted, AuditedEvent{EventID: event.Id, Summary: event.Summary, Start: start, End: end})
+	}
+	sort.SliceStable(accepted, func(i, j int) bool {
+		return accepted[i].Start.Before(accepted[j].Start)
+	})
+
+	report := &CalendarConflictReport{
+		CalendarID: calendarID,
+		Overlaps:   []EventOverlap{},
+		LongChains: []EventChain{},
+		OutOfHours: []AuditedEvent{},
+	}
+
+	// Events are sorted by start, so only later events starting before this one ends can overlap it
+	for i, first := range accepted {
+		for _, second := range accepted[i+1:] {
+			if !second.Start.Before(first.End) {
+				break
+			}
+			end := first.End
+			if second.End.Before(end) {
+				end = second.End
+			}
+			report.Overlaps = append(report.Overlaps, EventOverlap{
+				First:          first,
+				Second:         second,
+				OverlapMinutes: int(end.Sub(second.Start).Minutes()),
+			})
+		}
+	}
+
+	// A chain continues while the next event starts less than minBreak after the chain ends
+	for i := 0; i < len(accepted); {
+		chain := EventChain{Start: accepted[i].Start, End: accepted[i].End, Events: []AuditedEvent{accepted[i]}}
+		for i++; i < len(accepted) && accepted[i].Start.Sub(chain.End) < minBreak; i++ {
+			if accepted[i].End.After(chain.End) {
+				chain.End = accepted[i].End
+			}
+			chain.Events = append(chain.Events, accepted[i])
+		}
+		if len(chain.Events) > 1 && chain.End.Sub(chain.Start) > maxChain {
+			chain.DurationMinutes = int(chain.End.Sub(chain.Start).Minutes())
+			report.LongChains = append(report.LongChains, chain)
+		}
+	}
+
+	for _, event := range accepted {
+		outside := []TimeSlot{{Start: event.Start, End: event.End}}
+		for _, working := range workingHours.Intervals(event.Start, event.End) {
+			outside = subtractTimeSlot(outside, working)
+		}
+		if len(outside) > 0 {
+			report.OutOfHours = append(report.OutOfHours, event)
+		}
+	}
+
+	return report, nil
+}
+
+// ownerResponseStatus returns the response of a calendar's owner to an event:
+// that of the attendee whose email is calendarID or, on our own calendar, the
+// attendee marked as self. It is empty if the owner is not listed.
+func ownerResponseStatus(event *calendar.Event, calendarID string, own bool) string {
+	for _, attendee := range event.Attendees {
+		if strings.EqualFold(attendee.Email, calendarID) || (own && attendee.Self) {
+			return attendee.ResponseStatus
+		}
+	}
+	return ""
+}
@@ -161,6 +161,9 @@ const (
 	ErrCodeInvalidEventData   = "INVALID_EVENT_DATA"
 	ErrCodeEventConflict      = "EVENT_CONFLICT"
 	ErrCodeConfigurationError = "CONFIGURATION_ERROR"
+	ErrCodeACLRuleNotFound    = "ACL_RULE_NOT_FOUND"
+	ErrCodeInvalidACLRule     = "INVALID_ACL_RULE"
+	ErrCodeInvalidICSData     = "INVALID_ICS_DATA"
 )
 
 // ErrorResponse represents an error response for MCP tools
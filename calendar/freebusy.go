@@ -0,0 +1,213 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// QueryFreeBusy returns the busy blocks of several calendars, people or groups
+// and the intervals in which all of them are free
+func (s *googleCalendarService) QueryFreeBusy(ctx context.Context, req *FreeBusyRequest) (*FreeBusyResult, error) {
+	if !req.StartTime.Before(req.EndTime) {
+		return nil, NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+
+	calendarIDs := uniqueStrings(req.CalendarIDs)
+	if len(calendarIDs) == 0 {
+		calendarIDs = []string{s.config.CalendarID}
+	}
+	if len(calendarIDs) > MaxFreeBusyItems {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData,
+			fmt.Sprintf("Too many calendars: %d", len(calendarIDs)),
+			fmt.Sprintf("At most %d calendars and groups can be queried at once", MaxFreeBusyItems))
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &calendar.FreeBusyRequest{
+		TimeMin:              req.StartTime.Format(time.RFC3339),
+		TimeMax:              req.EndTime.Format(time.RFC3339),
+		CalendarExpansionMax: MaxFreeBusyItems,
+		GroupExpansionMax:    100,
+	}
+	for _, id := range calendarIDs {
+		query.Items = append(query.Items, &calendar.FreeBusyRequestItem{Id: id})
+	}
+
+	response, err := service.Freebusy.Query(query).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to query free/busy information")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to query free/busy information", err)
+	}
+
+	return ConvertFreeBusyResponse(response, calendarIDs, req.StartTime, req.EndTime), nil
+}
+
+// ConvertFreeBusyResponse converts a Freebusy.Query response, listing calendars
+// in request order followed by any calendars added by group expansion
+func ConvertFreeBusyResponse(response *calendar.FreeBusyResponse, calendarIDs []string, startTime, endTime time.Time) *FreeBusyResult {
+	result := &FreeBusyResult{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Calendars:  []CalendarBusy{},
+		CommonFree: []TimeSlot{},
+	}
+
+	var groupIDs []string
+	for id := range response.Groups {
+		groupIDs = append(groupIDs, id)
+	}
+	sort.Strings(groupIDs)
+	for _, id := range groupIDs {
+		group := response.Groups[id]
+		result.Groups = append(result.Groups, GroupExpansion{
+			GroupID:     id,
+			CalendarIDs: group.Calendars,
+			Errors:      formatFreeBusyErrors(group.Errors),
+		})
+	}
+
+	order := append([]string{}, calendarIDs...)
+	var expanded []string
+	for id := range response.Calendars {
+		if !containsString(order, id) {
+			expanded = append(expanded, id)
+		}
+	}
+	sort.Strings(expanded)
+	order = append(order, expanded...)
+
+	// Calendars whose availability is unknown are reported but do not narrow the common free time
+	var allBusy []TimeSlot
+	for _, id := range order {
+		freeBusy, ok := response.Calendars[id]
+		if !ok {
+			continue
+		}
+
+		calendarBusy := CalendarBusy{
+			CalendarID: id,
+			Busy:       []TimeSlot{},
+			Errors:     formatFreeBusyErrors(freeBusy.Errors),
+		}
+		for _, period := range freeBusy.Busy {
+			start, startErr := time.Parse(time.RFC3339, period.Start)
+			end, endErr := time.Parse(time.RFC3339, period.End)
+			if startErr != nil || endErr != nil {
+				calendarBusy.Errors = append(calendarBusy.Errors, fmt.Sprintf("invalid busy period %s - %s", period.Start, period.End))
+				continue
+			}
+			calendarBusy.Busy = append(calendarBusy.Busy, TimeSlot{Start: start, End: end})
+		}
+
+		if len(calendarBusy.Errors) == 0 {
+			allBusy = append(allBusy, calendarBusy.Busy...)
+		}
+		result.Calendars = append(result.Calendars, calendarBusy)
+	}
+
+	result.CommonFree = FreeSlotsBetween(startTime, endTime, allBusy)
+	return result
+}
+
+// formatFreeBusyErrors converts free/busy errors to their reasons, e.g. "notFound"
+func formatFreeBusyErrors(errors []*calendar.Error) []string {
+	var reasons []string
+	for _, e := range errors {
+		reasons = append(reasons, e.Reason)
+	}
+	return reasons
+}
+
+// MergeBusySlots sorts busy slots and merges overlapping or touching ones
+func MergeBusySlots(busy []TimeSlot) []TimeSlot {
+	sorted := make([]TimeSlot, 0, len(busy))
+	for _, slot := range busy {
+		if slot.End.After(slot.Start) {
+			sorted = append(sorted, TimeSlot{Start: slot.Start, End: slot.End})
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var merged []TimeSlot
+	for _, slot := range sorted {
+		if last := len(merged) - 1; last >= 0 && !slot.Start.After(merged[last].End) {
+			if slot.End.After(merged[last].End) {
+				merged[last].End = slot.End
+			}
+			continue
+		}
+		merged = append(merged, slot)
+	}
+
+	return merged
+}
+
+// FreeSlotsBetween returns the gaps between busy slots within [startTime, endTime)
+func FreeSlotsBetween(startTime, endTime time.Time, busy []TimeSlot) []TimeSlot {
+	free := []TimeSlot{}
+	cursor := startTime
+
+	for _, slot := range MergeBusySlots(busy) {
+		if !slot.End.After(cursor) {
+			continue
+		}
+		if !slot.Start.Before(endTime) {
+			break
+		}
+		if slot.Start.After(cursor) {
+			free = append(free, TimeSlot{Start: cursor, End: slot.Start, Free: true})
+		}
+		cursor = slot.End
+	}
+
+	if cursor.Before(endTime) {
+		free = append(free, TimeSlot{Start: cursor, End: endTime, Free: true})
+	}
+
+	return free
+}
+
+// uniqueStrings trims values and drops empty and duplicate entries, keeping order
+func uniqueStrings(values []string) []string {
+	var unique []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !containsString(unique, value) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether values contains value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
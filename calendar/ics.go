@@ -0,0 +1,1112 @@
+package calendar
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// iCalendar date and date-time layouts (RFC 5545 section 3.3.4 and 3.3.5)
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+	icsUTCLayout      = "20060102T150405Z"
+)
+
+// icsProperty is a single content line: NAME;PARAM=VALUE:value
+type icsProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// icsComponent is a BEGIN/END block with its properties and nested components
+type icsComponent struct {
+	Name       string
+	Properties []icsProperty
+	Children   []*icsComponent
+}
+
+// get returns the first property with the given name
+func (c *icsComponent) get(name string) (icsProperty, bool) {
+	for _, prop := range c.Properties {
+		if prop.Name == name {
+			return prop, true
+		}
+	}
+	return icsProperty{}, false
+}
+
+// all returns every property with the given name
+func (c *icsComponent) all(name string) []icsProperty {
+	var props []icsProperty
+	for _, prop := range c.Properties {
+		if prop.Name == name {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// ParseICS parses iCalendar (RFC 5545) data and returns its VEVENTs.
+// Floating times are interpreted in defaultLoc. Structural errors such as
+// unbalanced BEGIN/END lines fail the whole parse; problems within a single
+// VEVENT are reported on that event's ParseError so the others can still be used.
+func ParseICS(data string, defaultLoc *time.Location) ([]*ICSEvent, error) {
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+
+	root, err := parseICSComponents(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendars []*icsComponent
+	for _, child := range root.Children {
+		if child.Name == "VCALENDAR" {
+			calendars = append(calendars, child)
+		}
+	}
+	if len(calendars) == 0 {
+		return nil, fmt.Errorf("no VCALENDAR component found")
+	}
+
+	var events []*ICSEvent
+	for _, cal := range calendars {
+		zones := parseICSTimeZones(cal)
+		for _, child := range cal.Children {
+			if child.Name != "VEVENT" {
+				continue
+			}
+			events = append(events, parseICSEvent(child, zones, defaultLoc))
+		}
+	}
+
+	return events, nil
+}
+
+// ImportICS imports the VEVENTs in iCalendar data into the configured calendar.
+// Events keep their UID as iCalUID, so importing the same file again updates
+// the existing events instead of creating duplicates.
+func (s *googleCalendarService) ImportICS(ctx context.Context, data string) (*ImportResult, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidICSData, "iCalendar data is required", "")
+	}
+
+	defaultLoc, err := time.LoadLocation(s.config.TimeZone)
+	if err != nil {
+		defaultLoc = time.UTC
+	}
+
+	icsEvents, err := ParseICS(data, defaultLoc)
+	if err != nil {
+		return nil, NewInvalidInputError(ErrCodeInvalidICSData, "Failed to parse iCalendar data", err.Error())
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{
+		Total:   len(icsEvents),
+		Results: make([]ImportEventResult, 0, len(icsEvents)),
+	}
+
+	for _, icsEvent := range icsEvents {
+		eventResult := ImportEventResult{UID: icsEvent.UID, Summary: icsEvent.Summary}
+
+		googleEvent, err := s.convertICSEventToGoogle(icsEvent)
+		if err == nil {
+			var imported *calendar.Event
+			imported, err = service.Events.Import(s.config.CalendarID, googleEvent).Context(ctx).Do()
+			if err == nil {
+				eventResult.EventID = imported.Id
+			}
+		}
+
+		if err != nil {
+			eventResult.Error = err.Error()
+			result.Failed++
+		} else {
+			eventResult.Success = true
+			result.Imported++
+		}
+		result.Results = append(result.Results, eventResult)
+	}
+
+	log.Printf("Imported %d of %d iCalendar events into %s", result.Imported, result.Total, s.config.CalendarID)
+	return result, nil
+}
+
+// convertICSEventToGoogle converts a parsed VEVENT to a Google Calendar event for import
+func (s *googleCalendarService) convertICSEventToGoogle(icsEvent *ICSEvent) (*calendar.Event, error) {
+	if icsEvent.ParseError != nil {
+		return nil, icsEvent.ParseError
+	}
+	if err := validateEventProperties(icsEvent.Visibility, icsEvent.Transparency, "", icsEvent.Status); err != nil {
+		return nil, err
+	}
+
+	googleEvent := &calendar.Event{
+		ICalUID:      icsEvent.UID,
+		Summary:      icsEvent.Summary,
+		Description:  icsEvent.Description,
+		Location:     icsEvent.Location,
+		Status:       icsEvent.Status,
+		Transparency: icsEvent.Transparency,
+		Visibility:   icsEvent.Visibility,
+		Sequence:     int64(icsEvent.Sequence),
+		Recurrence:   icsEvent.Recurrence,
+	}
+
+	if icsEvent.AllDay {
+		googleEvent.Start = &calendar.EventDateTime{Date: icsEvent.Start.Format("2006-01-02")}
+		googleEvent.End = &calendar.EventDateTime{Date: icsEvent.End.Format("2006-01-02")}
+	} else {
+		// A timezone is required to expand recurring events; fall back to the calendar's own
+		timeZone := icsEvent.TimeZone
+		if timeZone == "" {
+			timeZone = s.config.TimeZone
+		}
+		googleEvent.Start = &calendar.EventDateTime{DateTime: icsEvent.Start.Format(time.RFC3339), TimeZone: timeZone}
+		googleEvent.End = &calendar.EventDateTime{DateTime: icsEvent.End.Format(time.RFC3339), TimeZone: timeZone}
+	}
+
+	if icsEvent.Organizer != "" {
+		googleEvent.Organizer = &calendar.EventOrganizer{Email: icsEvent.Organizer}
+	}
+
+	for _, attendee := range icsEvent.Attendees {
+		googleEvent.Attendees = append(googleEvent.Attendees, &calendar.EventAttendee{
+			Email:          attendee.Email,
+			DisplayName:    attendee.DisplayName,
+			ResponseStatus: attendee.ResponseStatus,
+			Optional:       attendee.Optional,
+			Resource:       attendee.Resource,
+		})
+	}
+
+	if len(icsEvent.Reminders) > 0 {
+		googleEvent.Reminders = convertRemindersToGoogle(icsEvent.Reminders)
+	}
+
+	return googleEvent, nil
+}
+
+// parseICSComponents unfolds the content lines and builds the component tree
+func parseICSComponents(data string) (*icsComponent, error) {
+	root := &icsComponent{}
+	stack := []*icsComponent{root}
+
+	for lineNum, line := range unfoldICSLines(data) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		prop, err := parseICSContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum+1, err)
+		}
+
+		current := stack[len(stack)-1]
+		switch prop.Name {
+		case "BEGIN":
+			component := &icsComponent{Name: strings.ToUpper(prop.Value)}
+			current.Children = append(current.Children, component)
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 1 || current.Name != strings.ToUpper(prop.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", lineNum+1, prop.Value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			current.Properties = append(current.Properties, prop)
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("unterminated %s component", stack[len(stack)-1].Name)
+	}
+
+	return root, nil
+}
+
+// unfoldICSLines splits data into logical lines, joining folded continuation lines
+func unfoldICSLines(data string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// parseICSContentLine parses a single unfolded content line
+func parseICSContentLine(line string) (icsProperty, error) {
+	prop := icsProperty{Params: make(map[string]string)}
+
+	// The name ends at the first ';' or ':'; the value starts at the first ':' outside quotes
+	nameEnd := strings.IndexAny(line, ";:")
+	if nameEnd <= 0 {
+		return prop, fmt.Errorf("invalid content line %q", line)
+	}
+	prop.Name = strings.ToUpper(line[:nameEnd])
+
+	rest := line[nameEnd:]
+	inQuotes := false
+	valueStart := -1
+	for i, r := range rest {
+		if r == '"' {
+			inQuotes = !inQuotes
+		} else if r == ':' && !inQuotes {
+			valueStart = i
+			break
+		}
+	}
+	if valueStart < 0 {
+		return prop, fmt.Errorf("missing value in content line %q", line)
+	}
+
+	prop.Value = rest[valueStart+1:]
+	for _, param := range splitICSParams(rest[:valueStart]) {
+		key, value, found := strings.Cut(param, "=")
+		if !found {
+			continue
+		}
+		prop.Params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return prop, nil
+}
+
+// splitICSParams splits ";A=1;B="x;y"" into parameters, respecting quotes
+func splitICSParams(params string) []string {
+	var result []string
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range params {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case r == ';' && !inQuotes:
+			if current.Len() > 0 {
+				result = append(result, current.String())
+			}
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		result = append(result, current.String())
+	}
+
+	return result
+}
+
+// unescapeICSText reverses TEXT value escaping (RFC 5545 section 3.3.11)
+func unescapeICSText(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+			switch value[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(value[i])
+			}
+			continue
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
+
+// parseICSTimeZones resolves the TZIDs defined by VTIMEZONE components.
+// IANA names are loaded directly; other TZIDs fall back to the fixed
+// standard-time offset declared in the VTIMEZONE.
+func parseICSTimeZones(cal *icsComponent) map[string]*time.Location {
+	zones := make(map[string]*time.Location)
+
+	for _, child := range cal.Children {
+		if child.Name != "VTIMEZONE" {
+			continue
+		}
+
+		tzidProp, ok := child.get("TZID")
+		if !ok {
+			continue
+		}
+		tzid := tzidProp.Value
+
+		if loc := loadICSLocation(tzid); loc != nil {
+			zones[tzid] = loc
+			continue
+		}
+
+		for _, rule := range child.Children {
+			if rule.Name != "STANDARD" {
+				continue
+			}
+			if offsetProp, ok := rule.get("TZOFFSETTO"); ok {
+				if offset, err := parseICSOffset(offsetProp.Value); err == nil {
+					zones[tzid] = time.FixedZone(tzid, offset)
+				}
+			}
+			break
+		}
+	}
+
+	return zones
+}
+
+// loadICSLocation loads an IANA timezone, also accepting prefixed TZIDs such as
+// "/mozilla.org/20050126_1/Europe/London". It returns nil if none matches.
+func loadICSLocation(tzid string) *time.Location {
+	if loc, err := time.LoadLocation(tzid); err == nil && tzid != "" {
+		return loc
+	}
+
+	parts := strings.Split(strings.Trim(tzid, "/"), "/")
+	for i := 1; i < len(parts); i++ {
+		if loc, err := time.LoadLocation(strings.Join(parts[i:], "/")); err == nil {
+			return loc
+		}
+	}
+
+	return nil
+}
+
+// parseICSOffset parses a UTC offset such as "+0100" or "-053000" into seconds
+func parseICSOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	sign := 1
+	switch value[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	hours, err := strconv.Atoi(value[1:3])
+	if err != nil {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+	minutes, err := strconv.Atoi(value[3:5])
+	if err != nil {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+	seconds := 0
+	if len(value) == 7 {
+		if seconds, err = strconv.Atoi(value[5:7]); err != nil {
+			return 0, fmt.Errorf("invalid UTC offset %q", value)
+		}
+	}
+
+	return sign * (hours*3600 + minutes*60 + seconds), nil
+}
+
+// parseICSEvent converts a VEVENT component to an ICSEvent
+func parseICSEvent(component *icsComponent, zones map[string]*time.Location, defaultLoc *time.Location) *ICSEvent {
+	event := &ICSEvent{}
+
+	if prop, ok := component.get("UID"); ok {
+		event.UID = prop.Value
+	}
+	if prop, ok := component.get("SUMMARY"); ok {
+		event.Summary = unescapeICSText(prop.Value)
+	}
+	if prop, ok := component.get("DESCRIPTION"); ok {
+		event.Description = unescapeICSText(prop.Value)
+	}
+	if prop, ok := component.get("LOCATION"); ok {
+		event.Location = unescapeICSText(prop.Value)
+	}
+	if prop, ok := component.get("STATUS"); ok {
+		event.Status = strings.ToLower(prop.Value)
+	}
+	if prop, ok := component.get("TRANSP"); ok {
+		event.Transparency = strings.ToLower(prop.Value)
+	}
+	if prop, ok := component.get("CLASS"); ok {
+		event.Visibility = strings.ToLower(prop.Value)
+	}
+	if prop, ok := component.get("SEQUENCE"); ok {
+		event.Sequence, _ = strconv.Atoi(prop.Value)
+	}
+	if prop, ok := component.get("ORGANIZER"); ok {
+		event.Organizer = icsMailto(prop.Value)
+	}
+
+	if event.UID == "" {
+		event.ParseError = fmt.Errorf("VEVENT has no UID")
+		return event
+	}
+
+	startProp, ok := component.get("DTSTART")
+	if !ok {
+		event.ParseError = fmt.Errorf("VEVENT %s has no DTSTART", event.UID)
+		return event
+	}
+
+	start, allDay, err := parseICSTime(startProp, zones, defaultLoc)
+	if err != nil {
+		event.ParseError = fmt.Errorf("invalid DTSTART: %w", err)
+		return event
+	}
+	event.Start = start
+	event.AllDay = allDay
+	if tzid := startProp.Params["TZID"]; tzid != "" {
+		if loc := loadICSLocation(tzid); loc != nil {
+			event.TimeZone = loc.String()
+		}
+	}
+
+	// The end comes from DTEND, DURATION, or defaults to one day (all-day) or the start time
+	if endProp, ok := component.get("DTEND"); ok {
+		end, _, err := parseICSTime(endProp, zones, defaultLoc)
+		if err != nil {
+			event.ParseError = fmt.Errorf("invalid DTEND: %w", err)
+			return event
+		}
+		event.End = end
+	} else if durationProp, ok := component.get("DURATION"); ok {
+		duration, err := parseICSDuration(durationProp.Value)
+		if err != nil {
+			event.ParseError = fmt.Errorf("invalid DURATION: %w", err)
+			return event
+		}
+		event.End = event.Start.Add(duration)
+	} else if event.AllDay {
+		event.End = event.Start.AddDate(0, 0, 1)
+	} else {
+		event.End = event.Start
+	}
+
+	if event.End.Before(event.Start) {
+		event.ParseError = fmt.Errorf("VEVENT %s ends before it starts", event.UID)
+		return event
+	}
+
+	// Recurrence lines are passed through unchanged; Google accepts RFC 5545 syntax
+	for _, name := range []string{"RRULE", "EXRULE", "RDATE", "EXDATE"} {
+		for _, prop := range component.all(name) {
+			event.Recurrence = append(event.Recurrence, formatICSProperty(prop))
+		}
+	}
+
+	for _, prop := range component.all("ATTENDEE") {
+		email := icsMailto(prop.Value)
+		if email == "" {
+			continue
+		}
+		cutype := strings.ToUpper(prop.Params["CUTYPE"])
+		role := strings.ToUpper(prop.Params["ROLE"])
+		event.Attendees = append(event.Attendees, Attendee{
+			Email:          email,
+			DisplayName:    prop.Params["CN"],
+			ResponseStatus: icsPartStatToResponseStatus(prop.Params["PARTSTAT"]),
+			Optional:       role == "OPT-PARTICIPANT" || role == "NON-PARTICIPANT",
+			Resource:       cutype == "ROOM" || cutype == "RESOURCE",
+			Organizer:      email == event.Organizer,
+		})
+	}
+
+	for _, child := range component.Children {
+		if child.Name != "VALARM" {
+			continue
+		}
+		if reminder, ok := parseICSAlarm(child, event.Start); ok && len(event.Reminders) < MaxReminderOverrides {
+			event.Reminders = append(event.Reminders, reminder)
+		}
+	}
+
+	return event
+}
+
+// parseICSTime parses a DATE or DATE-TIME property value
+func parseICSTime(prop icsProperty, zones map[string]*time.Location, defaultLoc *time.Location) (time.Time, bool, error) {
+	value := strings.TrimSpace(prop.Value)
+
+	if strings.EqualFold(prop.Params["VALUE"], "DATE") || len(value) == len(icsDateLayout) {
+		t, err := time.ParseInLocation(icsDateLayout, value, defaultLoc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icsUTCLayout, value)
+		return t, false, err
+	}
+
+	loc := defaultLoc
+	if tzid := prop.Params["TZID"]; tzid != "" {
+		if zone, ok := zones[tzid]; ok {
+			loc = zone
+		} else if zone := loadICSLocation(tzid); zone != nil {
+			loc = zone
+		} else {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tzid)
+		}
+	}
+
+	t, err := time.ParseInLocation(icsDateTimeLayout, value, loc)
+	return t, false, err
+}
+
+// parseICSDuration parses a duration such as "PT1H30M", "-PT15M", "P1D" or "P2W"
+func parseICSDuration(value string) (time.Duration, error) {
+	s := strings.TrimSpace(value)
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	s = s[1:]
+
+	var total time.Duration
+	inTime := false
+	number := ""
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			number += string(r)
+		case r == 'T':
+			inTime = true
+		default:
+			n, err := strconv.Atoi(number)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+			number = ""
+
+			switch {
+			case r == 'W' && !inTime:
+				total += time.Duration(n) * 7 * 24 * time.Hour
+			case r == 'D' && !inTime:
+				total += time.Duration(n) * 24 * time.Hour
+			case r == 'H' && inTime:
+				total += time.Duration(n) * time.Hour
+			case r == 'M' && inTime:
+				total += time.Duration(n) * time.Minute
+			case r == 'S' && inTime:
+				total += time.Duration(n) * time.Second
+			default:
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+		}
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return sign * total, nil
+}
+
+// parseICSAlarm converts a VALARM to a reminder. Alarms that fire after the
+// event starts or fall outside Google's limits cannot be represented and are skipped.
+func parseICSAlarm(alarm *icsComponent, start time.Time) (EventReminder, bool) {
+	method := ReminderMethodPopup
+	if action, ok := alarm.get("ACTION"); ok && strings.EqualFold(action.Value, "EMAIL") {
+		method = ReminderMethodEmail
+	}
+
+	trigger, ok := alarm.get("TRIGGER")
+	if !ok {
+		return EventReminder{}, false
+	}
+
+	var before time.Duration
+	if strings.EqualFold(trigger.Params["VALUE"], "DATE-TIME") {
+		at, err := time.Parse(icsUTCLayout, trigger.Value)
+		if err != nil {
+			return EventReminder{}, false
+		}
+		before = start.Sub(at)
+	} else {
+		if strings.EqualFold(trigger.Params["RELATED"], "END") {
+			return EventReminder{}, false
+		}
+		offset, err := parseICSDuration(trigger.Value)
+		if err != nil {
+			return EventReminder{}, false
+		}
+		before = -offset
+	}
+
+	minutes := int(before / time.Minute)
+	if minutes < 0 || minutes > MaxReminderMinutes {
+		return EventReminder{}, false
+	}
+
+	return EventReminder{Method: method, Minutes: minutes}, true
+}
+
+// icsMailto extracts the email address from a "mailto:" calendar address
+func icsMailto(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 7 && strings.EqualFold(value[:7], "mailto:") {
+		value = value[7:]
+	}
+	if !strings.Contains(value, "@") {
+		return ""
+	}
+	return value
+}
+
+// icsPartStatToResponseStatus maps an iCalendar PARTSTAT to a Google response status
+func icsPartStatToResponseStatus(partStat string) string {
+	switch strings.ToUpper(partStat) {
+	case "ACCEPTED":
+		return ResponseStatusAccepted
+	case "DECLINED":
+		return ResponseStatusDeclined
+	case "TENTATIVE":
+		return ResponseStatusTentative
+	default:
+		return ResponseStatusNeedsAction
+	}
+}
+
+// formatICSProperty formats a property back into a content line, e.g. "EXDATE;TZID=Europe/London:20240101T090000"
+func formatICSProperty(prop icsProperty) string {
+	var b strings.Builder
+	b.WriteString(prop.Name)
+
+	keys := make([]string, 0, len(prop.Params))
+	for key := range prop.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		b.WriteString(";")
+		b.WriteString(key)
+		b.WriteString("=")
+		b.WriteString(prop.Params[key])
+	}
+
+	b.WriteString(":")
+	b.WriteString(prop.Value)
+	return b.String()
+}
+
+// icsProductID identifies this server in exported VCALENDARs
+const icsProductID = "-//google_cal_mcp_golang//Google Calendar MCP Server//EN"
+
+// icsMaxLineOctets is the longest content line allowed before folding (RFC 5545 section 3.1)
+const icsMaxLineOctets = 75
+
+// ExportICS exports the events between startTime and endTime from the
+// configured calendar as iCalendar data. Recurring events are exported as a
+// single VEVENT with their recurrence rules rather than expanded instances.
+func (s *googleCalendarService) ExportICS(ctx context.Context, startTime, endTime time.Time) (string, error) {
+	if !startTime.Before(endTime) {
+		return "", NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Deleted instances are needed to turn cancelled occurrences into EXDATEs
+	var events []*Event
+	err = service.Events.List(s.config.CalendarID).
+		TimeMin(startTime.Format(time.RFC3339)).
+		TimeMax(endTime.Format(time.RFC3339)).
+		SingleEvents(false).
+		ShowDeleted(true).
+		MaxResults(2500).
+		Pages(ctx, func(page *calendar.Events) error {
+			for _, googleEvent := range page.Items {
+				events = append(events, s.convertGoogleEventToEvent(googleEvent))
+			}
+			return nil
+		})
+	if err != nil {
+		return "", NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve events", err)
+	}
+
+	return FormatICS(events, s.config.TimeZone, startTime, endTime), nil
+}
+
+// FormatICS serialises events to an RFC 5545 VCALENDAR. VTIMEZONE definitions
+// are generated for every timezone referenced, covering rangeStart to rangeEnd
+// and any earlier DTSTART, such as that of a series which began before the range.
+// Cancelled occurrences of recurring events become EXDATEs on their series;
+// other cancelled events are left out.
+func FormatICS(events []*Event, calendarTimeZone string, rangeStart, rangeEnd time.Time) string {
+	w := &icsWriter{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + icsProductID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if calendarTimeZone != "" {
+		w.line("X-WR-TIMEZONE:" + calendarTimeZone)
+	}
+
+	// Collect cancelled occurrences per series, the timezones in use and the
+	// earliest time written in each
+	exdates := make(map[string][]*Event)
+	zones := make(map[string]*time.Location)
+	zoneStarts := make(map[string]time.Time)
+	var zoneNames []string
+	for _, event := range events {
+		if event.Status == EventStatusCancelled {
+			if event.RecurringEventID != "" && event.OriginalStartTime != nil {
+				exdates[event.RecurringEventID] = append(exdates[event.RecurringEventID], event)
+			}
+			continue
+		}
+		if loc := icsEventLocation(event); loc != nil {
+			name := loc.String()
+			if _, seen := zones[name]; !seen {
+				zones[name] = loc
+				zoneStarts[name] = rangeStart
+				zoneNames = append(zoneNames, name)
+			}
+			if event.StartTime.Before(zoneStarts[name]) {
+				zoneStarts[name] = event.StartTime
+			}
+			if event.OriginalStartTime != nil && event.OriginalStartTime.Before(zoneStarts[name]) {
+				zoneStarts[name] = *event.OriginalStartTime
+			}
+		}
+	}
+
+	sort.Strings(zoneNames)
+	for _, name := range zoneNames {
+		writeICSTimeZone(w, zones[name], zoneStarts[name], rangeEnd)
+	}
+
+	for _, event := range events {
+		if event.Status == EventStatusCancelled {
+			continue
+		}
+		writeICSEvent(w, event, exdates[event.ID])
+	}
+
+	w.line("END:VCALENDAR")
+	return w.String()
+}
+
+// icsWriter builds iCalendar output with CRLF line endings and line folding
+type icsWriter struct {
+	strings.Builder
+}
+
+// line writes a content line, folding it at 75 octets without splitting UTF-8 sequences
+func (w *icsWriter) line(content string) {
+	limit := icsMaxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.WriteString(content[:cut])
+		w.WriteString("\r\n ")
+		content = content[cut:]
+		// The leading space of a continuation line counts towards its length
+		limit = icsMaxLineOctets - 1
+	}
+	w.WriteString(content)
+	w.WriteString("\r\n")
+}
+
+// writeICSEvent writes a single VEVENT
+func writeICSEvent(w *icsWriter, event *Event, cancelled []*Event) {
+	loc := icsEventLocation(event)
+
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + icsEventUID(event))
+	stamp := event.UpdatedAt
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	w.line("DTSTAMP:" + stamp.UTC().Format(icsUTCLayout))
+	if !event.CreatedAt.IsZero() {
+		w.line("CREATED:" + event.CreatedAt.UTC().Format(icsUTCLayout))
+	}
+	if !event.UpdatedAt.IsZero() {
+		w.line("LAST-MODIFIED:" + event.UpdatedAt.UTC().Format(icsUTCLayout))
+	}
+
+	w.line(formatICSTime("DTSTART", event.StartTime, event.AllDay, loc))
+	w.line(formatICSTime("DTEND", event.EndTime, event.AllDay, loc))
+	if event.OriginalStartTime != nil {
+		w.line(formatICSTime("RECURRENCE-ID", *event.OriginalStartTime, event.AllDay, loc))
+	}
+
+	for _, rule := range event.Recurrence {
+		w.line(rule)
+	}
+	for _, instance := range cancelled {
+		w.line(formatICSTime("EXDATE", *instance.OriginalStartTime, event.AllDay, loc))
+	}
+
+	w.line("SUMMARY:" + escapeICSText(event.Summary))
+	if event.Description != "" {
+		w.line("DESCRIPTION:" + escapeICSText(event.Description))
+	}
+	if event.Location != "" {
+		w.line("LOCATION:" + escapeICSText(event.Location))
+	}
+	if event.Status != "" {
+		w.line("STATUS:" + strings.ToUpper(event.Status))
+	}
+	if event.Transparency != "" {
+		w.line("TRANSP:" + strings.ToUpper(event.Transparency))
+	}
+	if event.Visibility != "" && event.Visibility != EventVisibilityDefault {
+		w.line("CLASS:" + strings.ToUpper(event.Visibility))
+	}
+	if event.HTMLLink != "" {
+		w.line("URL:" + event.HTMLLink)
+	}
+
+	if event.Organizer != "" {
+		w.line("ORGANIZER:mailto:" + event.Organizer)
+	}
+	for _, attendee := range event.AttendeeDetails {
+		w.line(formatICSAttendee(attendee))
+	}
+
+	if event.Reminders != nil {
+		for _, reminder := range event.Reminders.Overrides {
+			writeICSAlarm(w, reminder, event)
+		}
+	}
+
+	w.line("END:VEVENT")
+}
+
+// writeICSAlarm writes a VALARM for a reminder. Email alarms need a recipient,
+// so they fall back to display alarms when the event has no organizer.
+func writeICSAlarm(w *icsWriter, reminder EventReminder, event *Event) {
+	w.line("BEGIN:VALARM")
+	w.line("TRIGGER:" + formatICSDuration(-time.Duration(reminder.Minutes)*time.Minute))
+	if reminder.Method == ReminderMethodEmail && event.Organizer != "" {
+		w.line("ACTION:EMAIL")
+		w.line("SUMMARY:" + escapeICSText(event.Summary))
+		w.line("DESCRIPTION:" + escapeICSText("Reminder: "+event.Summary))
+		w.line("ATTENDEE:mailto:" + event.Organizer)
+	} else {
+		w.line("ACTION:DISPLAY")
+		w.line("DESCRIPTION:" + escapeICSText(event.Summary))
+	}
+	w.line("END:VALARM")
+}
+
+// writeICSTimeZone writes a VTIMEZONE with one observance per offset change
+// between from and to, which is valid without deriving rules from the tz database
+func writeICSTimeZone(w *icsWriter, loc *time.Location, from, to time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	start := from.In(loc)
+	name, offset := start.Zone()
+	writeICSObservance(w, start.IsDST(), start, name, offset, offset)
+
+	for _, transition := range zoneTransitions(loc, from, to) {
+		local := transition.In(loc)
+		newName, newOffset := local.Zone()
+		// DTSTART is expressed in the local time in effect before the change
+		writeICSObservance(w, local.IsDST(), transition.In(time.FixedZone("", offset)), newName, offset, newOffset)
+		offset = newOffset
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+// writeICSObservance writes a STANDARD or DAYLIGHT sub-component
+func writeICSObservance(w *icsWriter, daylight bool, start time.Time, name string, offsetFrom, offsetTo int) {
+	kind := "STANDARD"
+	if daylight {
+		kind = "DAYLIGHT"
+	}
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + start.Format(icsDateTimeLayout))
+	w.line("TZOFFSETFROM:" + formatICSOffset(offsetFrom))
+	w.line("TZOFFSETTO:" + formatICSOffset(offsetTo))
+	if name != "" {
+		w.line("TZNAME:" + name)
+	}
+	w.line("END:" + kind)
+}
+
+// zoneTransitions returns the instants in [from, to) at which loc's UTC offset changes
+func zoneTransitions(loc *time.Location, from, to time.Time) []time.Time {
+	var transitions []time.Time
+	_, previous := from.In(loc).Zone()
+
+	for day := from; day.Before(to); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		if next.After(to) {
+			next = to
+		}
+		if _, offset := next.In(loc).Zone(); offset == previous {
+			continue
+		}
+
+		// Binary search for the first second with the new offset
+		low, high := day, next
+		for high.Sub(low) > time.Second {
+			mid := low.Add(high.Sub(low) / 2)
+			if _, offset := mid.In(loc).Zone(); offset == previous {
+				low = mid
+			} else {
+				high = mid
+			}
+		}
+		transitions = append(transitions, high.Truncate(time.Second))
+		_, previous = high.In(loc).Zone()
+	}
+
+	return transitions
+}
+
+// icsEventLocation returns the timezone an event's times should be written in,
+// or nil for UTC and all-day events
+func icsEventLocation(event *Event) *time.Location {
+	if event.AllDay || event.TimeZone == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(event.TimeZone)
+	if err != nil || loc == time.UTC {
+		return nil
+	}
+	return loc
+}
+
+// icsEventUID returns the iCalendar UID for an event
+func icsEventUID(event *Event) string {
+	if event.ICalUID != "" {
+		return event.ICalUID
+	}
+	return event.ID + "@google.com"
+}
+
+// formatICSTime formats a DATE or DATE-TIME property
+func formatICSTime(name string, t time.Time, allDay bool, loc *time.Location) string {
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE:" + t.Format(icsDateLayout)
+	case loc != nil:
+		return name + ";TZID=" + loc.String() + ":" + t.In(loc).Format(icsDateTimeLayout)
+	default:
+		return name + ":" + t.UTC().Format(icsUTCLayout)
+	}
+}
+
+// formatICSAttendee formats an ATTENDEE property
+func formatICSAttendee(attendee Attendee) string {
+	var b strings.Builder
+	b.WriteString("ATTENDEE")
+	if attendee.DisplayName != "" {
+		b.WriteString(`;CN="` + strings.ReplaceAll(attendee.DisplayName, `"`, "'") + `"`)
+	}
+	if attendee.Resource {
+		b.WriteString(";CUTYPE=RESOURCE")
+	}
+	if attendee.Optional {
+		b.WriteString(";ROLE=OPT-PARTICIPANT")
+	} else {
+		b.WriteString(";ROLE=REQ-PARTICIPANT")
+	}
+	b.WriteString(";PARTSTAT=" + responseStatusToICSPartStat(attendee.ResponseStatus))
+	b.WriteString(":mailto:" + attendee.Email)
+	return b.String()
+}
+
+// responseStatusToICSPartStat maps a Google response status to an iCalendar PARTSTAT
+func responseStatusToICSPartStat(status string) string {
+	switch status {
+	case ResponseStatusAccepted:
+		return "ACCEPTED"
+	case ResponseStatusDeclined:
+		return "DECLINED"
+	case ResponseStatusTentative:
+		return "TENTATIVE"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// escapeICSText escapes a TEXT value (RFC 5545 section 3.3.11)
+func escapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
+
+// formatICSDuration formats a duration such as "-PT10M" or "P1D"
+func formatICSDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+
+	var b strings.Builder
+	b.WriteString(sign + "P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || days == 0 {
+		b.WriteString("T")
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 || hours == 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+	}
+	return b.String()
+}
+
+// formatICSOffset formats a UTC offset in seconds as "+HHMM"
+func formatICSOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, (seconds%3600)/60)
+}
@@ -6,23 +6,121 @@ import (
 
 // Event represents a calendar event
 type Event struct {
-	ID          string    `json:"id"`
-	Summary     string    `json:"summary"`
-	Description string    `json:"description,omitempty"`
-	StartTime   time.Time `json:"start_time"`
-	EndTime     time.Time `json:"end_time"`
-	Location    string    `json:"location,omitempty"`
-	Attendees   []string  `json:"attendees,omitempty"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                 string                     `json:"id"`
+	Summary            string                     `json:"summary"`
+	Description        string                     `json:"description,omitempty"`
+	StartTime          time.Time                  `json:"start_time"`
+	EndTime            time.Time                  `json:"end_time"`
+	AllDay             bool                       `json:"all_day,omitempty"`
+	TimeZone           string                     `json:"timezone,omitempty"`
+	Location           string                     `json:"location,omitempty"`
+	Attendees          []string                   `json:"attendees,omitempty"`
+	AttendeeDetails    []Attendee                 `json:"attendee_details,omitempty"`
+	Organizer          string                     `json:"organizer,omitempty"`
+	Reminders          *Reminders                 `json:"reminders,omitempty"`
+	Status             string                     `json:"status"`
+	Visibility         string                     `json:"visibility,omitempty"`
+	Transparency       string                     `json:"transparency,omitempty"`
+	ColorID            string                     `json:"color_id,omitempty"`
+	EventType          string                     `json:"event_type,omitempty"`
+	FocusTime          *FocusTimeProperties       `json:"focus_time,omitempty"`
+	OutOfOffice        *OutOfOfficeProperties     `json:"out_of_office,omitempty"`
+	WorkingLocation    *WorkingLocationProperties `json:"working_location,omitempty"`
+	ExtendedProperties *ExtendedProperties        `json:"extended_properties,omitempty"`
+	Attachments        []EventAttachment          `json:"attachments,omitempty"`
+	Recurrence         []string                   `json:"recurrence,omitempty"`
+	RecurringEventID   string                     `json:"recurring_event_id,omitempty"`
+	OriginalStartTime  *time.Time                 `json:"original_start_time,omitempty"`
+	ICalUID            string                     `json:"ical_uid,omitempty"`
+	Conference         *ConferenceInfo            `json:"conference,omitempty"`
+	HTMLLink           string                     `json:"html_link,omitempty"`
+	ETag               string                     `json:"etag,omitempty"`
+	CreatedAt          time.Time                  `json:"created_at"`
+	UpdatedAt          time.Time                  `json:"updated_at"`
+}
+
+// Attendee represents an event attendee and their response
+type Attendee struct {
+	Email          string `json:"email"`
+	DisplayName    string `json:"display_name,omitempty"`
+	ResponseStatus string `json:"response_status,omitempty"`
+	Optional       bool   `json:"optional,omitempty"`
+	Organizer      bool   `json:"organizer,omitempty"`
+	Self           bool   `json:"self,omitempty"`
+	Resource       bool   `json:"resource,omitempty"`
+}
+
+// ConferenceInfo represents the conferencing details of an event
+type ConferenceInfo struct {
+	ConferenceID string                 `json:"conference_id,omitempty"`
+	Solution     string                 `json:"solution,omitempty"`
+	EntryPoints  []ConferenceEntryPoint `json:"entry_points,omitempty"`
+}
+
+// ConferenceEntryPoint represents a way to join a conference
+type ConferenceEntryPoint struct {
+	Type  string `json:"type"`
+	URI   string `json:"uri"`
+	Label string `json:"label,omitempty"`
+}
+
+// Reminders represents the reminder configuration of an event
+type Reminders struct {
+	UseDefault bool            `json:"use_default"`
+	Overrides  []EventReminder `json:"overrides,omitempty"`
+}
+
+// EventReminder represents a single reminder override
+type EventReminder struct {
+	Method  string `json:"method"`
+	Minutes int    `json:"minutes"`
+}
+
+// ExtendedProperties holds key/value metadata attached to an event.
+// Private properties are only visible on this calendar's copy of the event,
+// shared properties are visible to all attendees.
+type ExtendedProperties struct {
+	Private map[string]string `json:"private,omitempty"`
+	Shared  map[string]string `json:"shared,omitempty"`
+}
+
+// EventAttachment represents a file (typically a Google Drive file) attached to an event
+type EventAttachment struct {
+	FileURL  string `json:"file_url"`
+	Title    string `json:"title,omitempty"`
+	MimeType string `json:"mime_type,omitempty"`
+	FileID   string `json:"file_id,omitempty"`
+	IconLink string `json:"icon_link,omitempty"`
+}
+
+// FocusTimeProperties holds the properties of a focus time event
+type FocusTimeProperties struct {
+	AutoDeclineMode string `json:"auto_decline_mode,omitempty"`
+	DeclineMessage  string `json:"decline_message,omitempty"`
+	ChatStatus      string `json:"chat_status,omitempty"`
+}
+
+// OutOfOfficeProperties holds the properties of an out-of-office event
+type OutOfOfficeProperties struct {
+	AutoDeclineMode string `json:"auto_decline_mode,omitempty"`
+	DeclineMessage  string `json:"decline_message,omitempty"`
+}
+
+// WorkingLocationProperties holds the properties of a working location event
+type WorkingLocationProperties struct {
+	Type       string `json:"type"`
+	Label      string `json:"label,omitempty"`
+	BuildingID string `json:"building_id,omitempty"`
+	FloorID    string `json:"floor_id,omitempty"`
+	DeskID     string `json:"desk_id,omitempty"`
 }
 
 // TimeSlot represents a time slot with availability information
 type TimeSlot struct {
-	Start time.Time `json:"start"`
-	End   time.Time `json:"end"`
-	Free  bool      `json:"free"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Free       bool      `json:"free"`
+	OutOfHours bool      `json:"out_of_hours,omitempty"`
 }
 
 // CalendarConfig holds configuration for calendar operations
@@ -35,6 +133,17 @@ type CalendarConfig struct {
 	LogLevel        string `json:"log_level"`
 	Environment     string `json:"environment"`
 	Debug           bool   `json:"debug"`
+	// WorkingHours holds the configured working hours, if any
+	WorkingHours *WorkingHoursConfig `json:"working_hours,omitempty"`
+	// Rooms is the configured room catalog. When nil, rooms are read from
+	// the Workspace directory as DirectoryAdmin.
+	Rooms          []Room `json:"rooms,omitempty"`
+	DirectoryAdmin string `json:"directory_admin,omitempty"`
+	// AppointmentTypes are the configured bookable appointments, by name
+	AppointmentTypes map[string]*AppointmentType `json:"appointment_types,omitempty"`
+	// TimeZoneExplicit reports whether TimeZone was set explicitly rather
+	// than defaulted, in which case the account's timezone takes precedence
+	TimeZoneExplicit bool `json:"-"`
 }
 
 // CalendarInfo represents basic calendar information
@@ -46,14 +155,87 @@ type CalendarInfo struct {
 	Location    string `json:"location,omitempty"`
 }
 
+// CalendarEntry represents a calendar in the user's calendar list
+type CalendarEntry struct {
+	ID              string `json:"id"`
+	Summary         string `json:"summary"`
+	SummaryOverride string `json:"summary_override,omitempty"`
+	Description     string `json:"description,omitempty"`
+	TimeZone        string `json:"timezone,omitempty"`
+	AccessRole      string `json:"access_role"`
+	ColorID         string `json:"color_id,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	ForegroundColor string `json:"foreground_color,omitempty"`
+	Primary         bool   `json:"primary"`
+	Hidden          bool   `json:"hidden"`
+	Selected        bool   `json:"selected"`
+}
+
+// CalendarCreateRequest represents a request to create a secondary calendar
+type CalendarCreateRequest struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+	TimeZone    string `json:"timezone,omitempty"`
+	Location    string `json:"location,omitempty"`
+}
+
+// CalendarUpdateRequest represents a request to update a calendar's metadata
+type CalendarUpdateRequest struct {
+	Summary     *string `json:"summary,omitempty"`
+	Description *string `json:"description,omitempty"`
+	TimeZone    *string `json:"timezone,omitempty"`
+	Location    *string `json:"location,omitempty"`
+}
+
+// CalendarSettings represents the user's Google Calendar settings
+type CalendarSettings struct {
+	TimeZone           string `json:"timezone"`
+	WeekStart          string `json:"week_start"`
+	Locale             string `json:"locale,omitempty"`
+	Format24HourTime   bool   `json:"format_24_hour_time"`
+	DefaultEventLength int    `json:"default_event_length_minutes,omitempty"`
+	HideWeekends       bool   `json:"hide_weekends"`
+	// All contains every setting returned by the Settings API, keyed by setting ID
+	All map[string]string `json:"all"`
+}
+
+// ACLRule represents an access control rule on a calendar
+type ACLRule struct {
+	ID         string `json:"id"`
+	Role       string `json:"role"`
+	ScopeType  string `json:"scope_type"`
+	ScopeValue string `json:"scope_value,omitempty"`
+}
+
+// ShareCalendarRequest represents a request to share a calendar
+type ShareCalendarRequest struct {
+	CalendarID        string `json:"calendar_id,omitempty"`
+	Role              string `json:"role"`
+	ScopeType         string `json:"scope_type"`
+	ScopeValue        string `json:"scope_value,omitempty"`
+	SendNotifications bool   `json:"send_notifications"`
+}
+
 // EventCreateRequest represents a request to create an event
 type EventCreateRequest struct {
-	Summary     string    `json:"summary"`
-	Description string    `json:"description,omitempty"`
-	StartTime   time.Time `json:"start_time"`
-	EndTime     time.Time `json:"end_time"`
-	Location    string    `json:"location,omitempty"`
-	Attendees   []string  `json:"attendees,omitempty"`
+	Summary            string              `json:"summary"`
+	Description        string              `json:"description,omitempty"`
+	StartTime          time.Time           `json:"start_time"`
+	EndTime            time.Time           `json:"end_time"`
+	Location           string              `json:"location,omitempty"`
+	Attendees          []string            `json:"attendees,omitempty"`
+	Reminders          []EventReminder     `json:"reminders,omitempty"`
+	Visibility         string              `json:"visibility,omitempty"`
+	Transparency       string              `json:"transparency,omitempty"`
+	ColorID            string              `json:"color_id,omitempty"`
+	Status             string              `json:"status,omitempty"`
+	ExtendedProperties *ExtendedProperties `json:"extended_properties,omitempty"`
+	Attachments        []EventAttachment   `json:"attachments,omitempty"`
+	// Rooms are resource calendar emails added as resource attendees
+	Rooms []string `json:"rooms,omitempty"`
+	// AllowConflicts creates the event even if it overlaps busy time on the
+	// organizer's or an attendee's calendar
+	AllowConflicts bool `json:"allow_conflicts,omitempty"`
 }
 
 // EventUpdateRequest represents a request to update an event
@@ -64,6 +246,94 @@ type EventUpdateRequest struct {
 	EndTime     *time.Time `json:"end_time,omitempty"`
 	Location    *string    `json:"location,omitempty"`
 	Attendees   []string   `json:"attendees,omitempty"`
+	// Reminders replaces the reminder overrides when non-nil; an empty
+	// slice restores the calendar's default reminders.
+	Reminders    []EventReminder `json:"reminders,omitempty"`
+	Visibility   *string         `json:"visibility,omitempty"`
+	Transparency *string         `json:"transparency,omitempty"`
+	ColorID      *string         `json:"color_id,omitempty"`
+	Status       *string         `json:"status,omitempty"`
+	// ExtendedProperties are merged into the existing properties; keys with
+	// an empty value are removed.
+	ExtendedProperties *ExtendedProperties `json:"extended_properties,omitempty"`
+	// Attachments replaces the attachments when non-nil; an empty slice
+	// removes all attachments.
+	Attachments []EventAttachment `json:"attachments,omitempty"`
+	// AllowConflicts applies new times or attendees even if they overlap busy
+	// time on the organizer's or an attendee's calendar
+	AllowConflicts bool `json:"allow_conflicts,omitempty"`
+}
+
+// FocusTimeRequest represents a request to create a focus time event
+type FocusTimeRequest struct {
+	Summary         string    `json:"summary,omitempty"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	AutoDeclineMode string    `json:"auto_decline_mode,omitempty"`
+	DeclineMessage  string    `json:"decline_message,omitempty"`
+	ChatStatus      string    `json:"chat_status,omitempty"`
+}
+
+// OutOfOfficeRequest represents a request to create an out-of-office event
+type OutOfOfficeRequest struct {
+	Summary         string    `json:"summary,omitempty"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	AutoDeclineMode string    `json:"auto_decline_mode,omitempty"`
+	DeclineMessage  string    `json:"decline_message,omitempty"`
+}
+
+// WorkingLocationRequest represents a request to set the working location
+type WorkingLocationRequest struct {
+	StartTime time.Time                 `json:"start_time"`
+	EndTime   time.Time                 `json:"end_time"`
+	Location  WorkingLocationProperties `json:"location"`
+}
+
+// EventColor represents an entry of the calendar event color palette
+type EventColor struct {
+	ID         string `json:"id"`
+	Background string `json:"background"`
+	Foreground string `json:"foreground"`
+}
+
+// ICSEvent represents a VEVENT parsed from iCalendar (RFC 5545) data
+type ICSEvent struct {
+	UID          string          `json:"uid"`
+	Summary      string          `json:"summary"`
+	Description  string          `json:"description,omitempty"`
+	Location     string          `json:"location,omitempty"`
+	Start        time.Time       `json:"start"`
+	End          time.Time       `json:"end"`
+	AllDay       bool            `json:"all_day,omitempty"`
+	TimeZone     string          `json:"timezone,omitempty"`
+	Recurrence   []string        `json:"recurrence,omitempty"`
+	Organizer    string          `json:"organizer,omitempty"`
+	Attendees    []Attendee      `json:"attendees,omitempty"`
+	Reminders    []EventReminder `json:"reminders,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	Transparency string          `json:"transparency,omitempty"`
+	Visibility   string          `json:"visibility,omitempty"`
+	Sequence     int             `json:"sequence,omitempty"`
+	// ParseError is set when the VEVENT could not be fully parsed
+	ParseError error `json:"-"`
+}
+
+// ImportResult summarizes an iCalendar import
+type ImportResult struct {
+	Total    int                 `json:"total"`
+	Imported int                 `json:"imported"`
+	Failed   int                 `json:"failed"`
+	Results  []ImportEventResult `json:"results"`
+}
+
+// ImportEventResult reports the outcome of importing a single VEVENT
+type ImportEventResult struct {
+	UID     string `json:"uid"`
+	Summary string `json:"summary,omitempty"`
+	Success bool   `json:"success"`
+	EventID string `json:"event_id,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
 
 // AvailabilityRequest represents a request to check availability
@@ -71,6 +341,274 @@ type AvailabilityRequest struct {
 	StartTime  time.Time `json:"start_time"`
 	EndTime    time.Time `json:"end_time"`
 	CalendarID string    `json:"calendar_id,omitempty"`
+	// WorkingHoursOnly marks free time outside the calendar owner's working hours as out of hours
+	WorkingHoursOnly bool `json:"working_hours_only,omitempty"`
+	// Buffer pads both sides of every busy event, e.g. for travel time
+	Buffer time.Duration `json:"buffer,omitempty"`
+	// MinSlotDuration drops free slots shorter than this
+	MinSlotDuration time.Duration `json:"min_slot_duration,omitempty"`
+	// SlotSize splits free time into bookable slots of this length, aligned to
+	// multiples of SlotSize from midnight in the calendar's timezone
+	SlotSize time.Duration `json:"slot_size,omitempty"`
+	// BusyRules decides which events block time
+	BusyRules BusyRules `json:"busy_rules"`
+}
+
+// BusyRules decides which events count as busy. The zero value matches Google
+// Calendar: cancelled, transparent ("free") and declined events do not block
+// time, while tentative and unanswered events do.
+type BusyRules struct {
+	CountTransparent bool `json:"count_transparent,omitempty"`
+	CountDeclined    bool `json:"count_declined,omitempty"`
+	TentativeAsFree  bool `json:"tentative_as_free,omitempty"`
+}
+
+// WorkingHoursConfig is the configured working hours: a default for the
+// calendar owner and overrides for other people, keyed by email address
+type WorkingHoursConfig struct {
+	Default *WorkingHoursSpec            `json:"default,omitempty"`
+	People  map[string]*WorkingHoursSpec `json:"people,omitempty"`
+}
+
+// WorkingHoursSpec describes one person's working week. Days maps day names
+// to "HH:MM-HH:MM" ranges; omitted days are not worked. Lunch is removed from
+// every working day. If Days is empty, Monday to Friday 09:00-17:00 is used.
+type WorkingHoursSpec struct {
+	TimeZone string              `json:"timezone,omitempty"`
+	Days     map[string][]string `json:"days,omitempty"`
+	Lunch    string              `json:"lunch,omitempty"`
+}
+
+// WorkingHours is a resolved working week in a specific timezone
+type WorkingHours struct {
+	Location *time.Location
+	Days     map[time.Weekday][]TimeOfDayRange
+}
+
+// FreeBusyRequest represents a request for the busy times of several calendars.
+// CalendarIDs may contain calendar IDs, people's email addresses and group
+// addresses; groups are expanded to their members' calendars.
+type FreeBusyRequest struct {
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	CalendarIDs []string  `json:"calendar_ids"`
+}
+
+// FreeBusyResult holds the busy times of each calendar and the time all are free
+type FreeBusyResult struct {
+	StartTime  time.Time        `json:"start_time"`
+	EndTime    time.Time        `json:"end_time"`
+	Calendars  []CalendarBusy   `json:"calendars"`
+	Groups     []GroupExpansion `json:"groups,omitempty"`
+	CommonFree []TimeSlot       `json:"common_free"`
+}
+
+// CalendarBusy holds the busy blocks of a single calendar. Errors such as
+// "notFound" mean the calendar's availability is unknown.
+type CalendarBusy struct {
+	CalendarID string     `json:"calendar_id"`
+	Busy       []TimeSlot `json:"busy"`
+	Errors     []string   `json:"errors,omitempty"`
+}
+
+// GroupExpansion lists the calendars a group address was expanded to
+type GroupExpansion struct {
+	GroupID     string   `json:"group_id"`
+	CalendarIDs []string `json:"calendar_ids"`
+	Errors      []string `json:"errors,omitempty"`
+}
+
+// EventConflict is existing busy time on a calendar that overlaps a proposed
+// event. EventID and Summary are only known for the organizer's calendar;
+// other calendars only expose busy blocks.
+type EventConflict struct {
+	CalendarID string    `json:"calendar_id"`
+	EventID    string    `json:"event_id,omitempty"`
+	Summary    string    `json:"summary,omitempty"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
+
+// MovedEvent is an existing event whose time is changing, so that it does not
+// conflict with itself. Calendars lists the calendars that had the event at
+// its Previous time: the organizer's and those of attendees who had not
+// declined.
+type MovedEvent struct {
+	EventID   string   `json:"event_id"`
+	Previous  TimeSlot `json:"previous"`
+	Calendars []string `json:"calendars"`
+}
+
+// Room represents a bookable Workspace resource such as a meeting room.
+// Email is the resource calendar ID used for free/busy and invitations.
+type Room struct {
+	Email       string   `json:"email"`
+	Name        string   `json:"name"`
+	Building    string   `json:"building,omitempty"`
+	Floor       string   `json:"floor,omitempty"`
+	Capacity    int      `json:"capacity,omitempty"`
+	Features    []string `json:"features,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// RoomSearchRequest filters rooms by capacity, building and features. If a
+// time range is given, only rooms free for all of it are returned.
+type RoomSearchRequest struct {
+	MinCapacity int       `json:"min_capacity,omitempty"`
+	Building    string    `json:"building,omitempty"`
+	Features    []string  `json:"features,omitempty"`
+	StartTime   time.Time `json:"start_time,omitempty"`
+	EndTime     time.Time `json:"end_time,omitempty"`
+}
+
+// AppointmentType defines an appointment that external people can book on
+// the configured calendar. Windows uses the same format as working hours
+// days; without windows the calendar owner's working hours apply. Buffers
+// are kept free around every busy event, MaxPerDay caps the bookings of this
+// type per day, and slots must start at least MinNoticeMinutes from now and
+// at most MaxDaysAhead days ahead.
+type AppointmentType struct {
+	Title               string              `json:"title"`
+	Description         string              `json:"description,omitempty"`
+	Location            string              `json:"location,omitempty"`
+	DurationMinutes     int                 `json:"duration_minutes"`
+	TimeZone            string              `json:"timezone,omitempty"`
+	Windows             map[string][]string `json:"windows,omitempty"`
+	BufferBeforeMinutes int                 `json:"buffer_before_minutes,omitempty"`
+	BufferAfterMinutes  int                 `json:"buffer_after_minutes,omitempty"`
+	MaxPerDay           int                 `json:"max_per_day,omitempty"`
+	MinNoticeMinutes    int                 `json:"min_notice_minutes,omitempty"`
+	MaxDaysAhead        int                 `json:"max_days_ahead,omitempty"`
+	SlotStepMinutes     int                 `json:"slot_step_minutes,omitempty"`
+}
+
+// BookingRequest represents a request to book an appointment slot
+type BookingRequest struct {
+	AppointmentType string    `json:"appointment_type"`
+	StartTime       time.Time `json:"start_time"`
+	AttendeeEmail   string    `json:"attendee_email"`
+	AttendeeName    string    `json:"attendee_name,omitempty"`
+	Notes           string    `json:"notes,omitempty"`
+}
+
+// RescheduleRequest represents a request for new times for an existing
+// event within a search window, using the same preferences as meeting
+// suggestions
+type RescheduleRequest struct {
+	EventID        string           `json:"event_id"`
+	StartTime      time.Time        `json:"start_time"`
+	EndTime        time.Time        `json:"end_time"`
+	TimeZone       string           `json:"timezone,omitempty"`
+	WorkingHours   *TimeOfDayRange  `json:"working_hours,omitempty"`
+	PreferredDays  []time.Weekday   `json:"preferred_days,omitempty"`
+	PreferredTimes []TimeOfDayRange `json:"preferred_times,omitempty"`
+	MaxSuggestions int              `json:"max_suggestions,omitempty"`
+}
+
+// RescheduleProposal holds the proposed new times for an event. Required
+// attendees are free at every suggestion; optional attendees may not be.
+type RescheduleProposal struct {
+	Event               *Event              `json:"event"`
+	RequiredAttendees   []string            `json:"required_attendees"`
+	OptionalAttendees   []string            `json:"optional_attendees,omitempty"`
+	Suggestions         []MeetingSuggestion `json:"suggestions"`
+	UnknownAvailability []string            `json:"unknown_availability,omitempty"`
+}
+
+// ConflictReportRequest represents a request to audit calendars for
+// double-bookings, long runs of back-to-back events and events outside
+// working hours. Gaps shorter than MinBreak do not count as a break.
+type ConflictReportRequest struct {
+	StartTime   time.Time     `json:"start_time"`
+	EndTime     time.Time     `json:"end_time"`
+	CalendarIDs []string      `json:"calendar_ids,omitempty"`
+	MaxChain    time.Duration `json:"max_chain"`
+	MinBreak    time.Duration `json:"min_break"`
+}
+
+// ConflictReport holds the audit of each requested calendar
+type ConflictReport struct {
+	StartTime time.Time                `json:"start_time"`
+	EndTime   time.Time                `json:"end_time"`
+	Calendars []CalendarConflictReport `json:"calendars"`
+}
+
+// CalendarConflictReport lists the problems found on one calendar. Error is
+// set instead if the calendar could not be read.
+type CalendarConflictReport struct {
+	CalendarID string         `json:"calendar_id"`
+	Overlaps   []EventOverlap `json:"overlaps"`
+	LongChains []EventChain   `json:"long_chains"`
+	OutOfHours []AuditedEvent `json:"outside_working_hours"`
+	Error      string         `json:"error,omitempty"`
+}
+
+// AuditedEvent identifies an event in a conflict report
+type AuditedEvent struct {
+	EventID string    `json:"event_id"`
+	Summary string    `json:"summary"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+}
+
+// EventOverlap is a pair of accepted events that overlap
+type EventOverlap struct {
+	First          AuditedEvent `json:"first"`
+	Second         AuditedEvent `json:"second"`
+	OverlapMinutes int          `json:"overlap_minutes"`
+}
+
+// EventChain is a run of back-to-back events without a break
+type EventChain struct {
+	Start           time.Time      `json:"start"`
+	End             time.Time      `json:"end"`
+	DurationMinutes int            `json:"duration_minutes"`
+	Events          []AuditedEvent `json:"events"`
+}
+
+// TimeOfDayRange is a daily clock-time range, in minutes after midnight
+type TimeOfDayRange struct {
+	Start int `json:"start_minute"`
+	End   int `json:"end_minute"`
+}
+
+// MeetingSuggestionRequest represents a request to find meeting times for a group
+type MeetingSuggestionRequest struct {
+	Attendees         []string         `json:"attendees"`
+	Duration          time.Duration    `json:"duration"`
+	StartTime         time.Time        `json:"start_time"`
+	EndTime           time.Time        `json:"end_time"`
+	TimeZone          string           `json:"timezone,omitempty"`
+	WorkingHours      *TimeOfDayRange  `json:"working_hours,omitempty"`
+	PreferredDays     []time.Weekday   `json:"preferred_days,omitempty"`
+	PreferredTimes    []TimeOfDayRange `json:"preferred_times,omitempty"`
+	BufferBefore      time.Duration    `json:"buffer_before,omitempty"`
+	BufferAfter       time.Duration    `json:"buffer_after,omitempty"`
+	MaxMeetingsPerDay int              `json:"max_meetings_per_day,omitempty"`
+	MaxSuggestions    int              `json:"max_suggestions,omitempty"`
+	// OptionalAttendees are soft constraints: suggestions when they are busy
+	// score lower instead of being excluded
+	OptionalAttendees []string `json:"optional_attendees,omitempty"`
+	// AttendeeHours holds each calendar's working hours, used when WorkingHours is nil
+	AttendeeHours map[string]*WorkingHours `json:"-"`
+	// Moving is the event being rescheduled, whose own busy time is disregarded
+	Moving *MovedEvent `json:"-"`
+}
+
+// MeetingSuggestion is a candidate meeting slot with its score (0-100) and the reasons for it
+type MeetingSuggestion struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Score   float64   `json:"score"`
+	Reasons []string  `json:"reasons"`
+}
+
+// MeetingSuggestionResult holds ranked meeting slots. Calendars listed in
+// UnknownAvailability could not be checked and were not taken into account.
+type MeetingSuggestionResult struct {
+	Suggestions         []MeetingSuggestion `json:"suggestions"`
+	CandidatesEvaluated int                 `json:"candidates_evaluated"`
+	UnknownAvailability []string            `json:"unknown_availability,omitempty"`
 }
 
 // SearchRequest represents a request to search events
@@ -79,6 +617,7 @@ type SearchRequest struct {
 	StartTime  *time.Time `json:"start_time,omitempty"`
 	EndTime    *time.Time `json:"end_time,omitempty"`
 	MaxResults int        `json:"max_results,omitempty"`
+	PropertyFilter
 }
 
 // ListEventsRequest represents a request to list events
@@ -86,8 +625,43 @@ type ListEventsRequest struct {
 	StartTime  time.Time `json:"start_time"`
 	EndTime    time.Time `json:"end_time"`
 	MaxResults int       `json:"max_results,omitempty"`
+	PropertyFilter
+
+	// ShowDeleted includes cancelled events and cancelled instances
+	ShowDeleted bool `json:"show_deleted,omitempty"`
+	// HideDeclined excludes events the calendar owner has declined
+	HideDeclined bool `json:"hide_declined,omitempty"`
+	// OrganizedByMe only returns events organized by the calendar owner
+	OrganizedByMe bool `json:"organized_by_me,omitempty"`
+	// WithAttendeesOnly only returns events that have attendees
+	WithAttendeesOnly bool `json:"with_attendees_only,omitempty"`
+	// EventTypes only returns events of the given types
+	EventTypes []string `json:"event_types,omitempty"`
 }
 
+// PropertyFilter restricts results to events whose extended properties
+// match all of the given key/value pairs
+type PropertyFilter struct {
+	PrivateProperties map[string]string `json:"private_properties,omitempty"`
+	SharedProperties  map[string]string `json:"shared_properties,omitempty"`
+}
+
+// Calendar access role constants
+const (
+	AccessRoleFreeBusyReader = "freeBusyReader"
+	AccessRoleReader         = "reader"
+	AccessRoleWriter         = "writer"
+	AccessRoleOwner          = "owner"
+)
+
+// ACL scope type constants
+const (
+	ACLScopeUser    = "user"
+	ACLScopeGroup   = "group"
+	ACLScopeDomain  = "domain"
+	ACLScopeDefault = "default"
+)
+
 // EventStatus constants
 const (
 	EventStatusConfirmed = "confirmed"
@@ -95,6 +669,97 @@ const (
 	EventStatusCancelled = "cancelled"
 )
 
+// Attendee response status constants
+const (
+	ResponseStatusNeedsAction = "needsAction"
+	ResponseStatusDeclined    = "declined"
+	ResponseStatusTentative   = "tentative"
+	ResponseStatusAccepted    = "accepted"
+)
+
+// EventVisibility constants
+const (
+	EventVisibilityDefault      = "default"
+	EventVisibilityPublic       = "public"
+	EventVisibilityPrivate      = "private"
+	EventVisibilityConfidential = "confidential"
+)
+
+// EventTransparency constants
+const (
+	EventTransparencyOpaque      = "opaque"
+	EventTransparencyTransparent = "transparent"
+)
+
+// EventType constants
+const (
+	EventTypeDefault         = "default"
+	EventTypeFocusTime       = "focusTime"
+	EventTypeOutOfOffice     = "outOfOffice"
+	EventTypeWorkingLocation = "workingLocation"
+	EventTypeFromGmail       = "fromGmail"
+	EventTypeBirthday        = "birthday"
+)
+
+// AutoDeclineMode constants for focus time and out-of-office events
+const (
+	AutoDeclineNone           = "declineNone"
+	AutoDeclineAllConflicting = "declineAllConflictingInvitations"
+	AutoDeclineOnlyNew        = "declineOnlyNewConflictingInvitations"
+)
+
+// ChatStatus constants for focus time events
+const (
+	ChatStatusAvailable    = "available"
+	ChatStatusDoNotDisturb = "doNotDisturb"
+)
+
+// WorkingLocation type constants
+const (
+	WorkingLocationHomeOffice = "homeOffice"
+	WorkingLocationOffice     = "officeLocation"
+	WorkingLocationCustom     = "customLocation"
+)
+
+// Reminder method constants
+const (
+	ReminderMethodPopup = "popup"
+	ReminderMethodEmail = "email"
+)
+
+// Reminder limits enforced by Google Calendar
+const (
+	MaxReminderOverrides = 5
+	MaxReminderMinutes   = 40320 // 4 weeks
+)
+
+// MaxAttachments is the maximum number of attachments per event
+const MaxAttachments = 25
+
+// Meeting suggestion defaults
+const (
+	DefaultMaxSuggestions = 5
+	MeetingSlotStep       = 15 * time.Minute
+)
+
+// Conflict report defaults
+const (
+	DefaultMaxChain = 3 * time.Hour
+	DefaultMinBreak = 10 * time.Minute
+)
+
+// DefaultRescheduleWindowDays is how far ahead new times are searched for by default
+const DefaultRescheduleWindowDays = 7
+
+// Appointment booking defaults
+const (
+	DefaultBookingDaysAhead    = 14
+	AppointmentTypePropertyKey = "appointment_type"
+)
+
+// MaxFreeBusyItems is the maximum number of calendars and groups per free/busy query
+const MaxFreeBusyItems = 50
+
 // Default values
 const (
 	DefaultMaxResults = 50
@@ -0,0 +1,187 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// ProposeReschedule suggests new times for an event at which the organizer
+// and all required attendees are free, keeping its duration. Optional
+// attendees are soft constraints that only lower a suggestion's score. The
+// event's current time does not count as busy, and is never suggested.
+func (s *googleCalendarService) ProposeReschedule(ctx context.Context, req *RescheduleRequest) (*RescheduleProposal, error) {
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.getReschedulableEvent(ctx, service, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := s.convertGoogleEventToEvent(event)
+	required, optional := rescheduleAttendees(event)
+
+	startTime, endTime := req.StartTime, req.EndTime
+	if startTime.IsZero() {
+		startTime = time.Now().Truncate(MeetingSlotStep).Add(MeetingSlotStep)
+	}
+	if endTime.IsZero() {
+		endTime = startTime.AddDate(0, 0, DefaultRescheduleWindowDays)
+	}
+
+	maxSuggestions := req.MaxSuggestions
+	if maxSuggestions <= 0 {
+		maxSuggestions = DefaultMaxSuggestions
+	}
+
+	// Ask for one extra in case the current time is among the suggestions
+	result, err := s.SuggestMeetingTimes(ctx, &MeetingSuggestionRequest{
+		Attendees:         required,
+		OptionalAttendees: optional,
+		Duration:          current.EndTime.Sub(current.StartTime),
+		StartTime:         startTime,
+		EndTime:           endTime,
+		TimeZone:          req.TimeZone,
+		WorkingHours:      req.WorkingHours,
+		PreferredDays:     req.PreferredDays,
+		PreferredTimes:    req.PreferredTimes,
+		MaxSuggestions:    maxSuggestions + 1,
+		Moving: &MovedEvent{
+			EventID:   event.Id,
+			Previous:  TimeSlot{Start: current.StartTime, End: current.EndTime},
+			Calendars: movedEventCalendars(s.config.CalendarID, event),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &RescheduleProposal{
+		Event:               current,
+		RequiredAttendees:   required,
+		OptionalAttendees:   optional,
+		Suggestions:         []MeetingSuggestion{},
+		UnknownAvailability: result.UnknownAvailability,
+	}
+	if proposal.RequiredAttendees == nil {
+		proposal.RequiredAttendees = []string{}
+	}
+	for _, suggestion := range result.Suggestions {
+		if len(proposal.Suggestions) < maxSuggestions && !suggestion.Start.Equal(current.StartTime) {
+			proposal.Suggestions = append(proposal.Suggestions, suggestion)
+		}
+	}
+
+	return proposal, nil
+}
+
+// RescheduleEvent moves an event to a new start time, keeping its duration,
+// and notifies the attendees. Unless allowConflicts is set, the move is
+// refused if the organizer or a required attendee is busy at the new time.
+func (s *googleCalendarService) RescheduleEvent(ctx context.Context, eventID string, newStartTime time.Time, allowConflicts bool) (*Event, error) {
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.getReschedulableEvent(ctx, service, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, _ := s.parseEventDateTime(event.Start)
+	endTime, _ := s.parseEventDateTime(event.End)
+	newEndTime := newStartTime.Add(endTime.Sub(startTime))
+
+	if !allowConflicts {
+		required, _ := rescheduleAttendees(event)
+		moved := &MovedEvent{
+			EventID:   eventID,
+			Previous:  TimeSlot{Start: startTime, End: endTime},
+			Calendars: movedEventCalendars(s.config.CalendarID, event),
+		}
+		if err := s.checkEventConflicts(ctx, service, newStartTime, newEndTime, required, event.Organizer, moved); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep the event's own timezone so the move does not change how it is displayed
+	patch := &calendar.Event{
+		Start: &calendar.EventDateTime{DateTime: newStartTime.Format(time.RFC3339), TimeZone: event.Start.TimeZone},
+		End:   &calendar.EventDateTime{DateTime: newEndTime.Format(time.RFC3339), TimeZone: event.End.TimeZone},
+	}
+
+	patched, err := service.Events.Patch(s.config.CalendarID, eventID, patch).
+		SendUpdates("all").
+		Context(ctx).
+		Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to reschedule event")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to reschedule event", err)
+	}
+
+	log.Printf("Rescheduled event %s from %s to %s", eventID, startTime.Format(time.RFC3339), newStartTime.Format(time.RFC3339))
+	return s.convertGoogleEventToEvent(patched), nil
+}
+
+// getReschedulableEvent retrieves an event, checking that it is a single timed event
+func (s *googleCalendarService) getReschedulableEvent(ctx context.Context, service *calendar.Service, eventID string) (*calendar.Event, error) {
+	if eventID == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Event ID is required", "")
+	}
+
+	event, err := service.Events.Get(s.config.CalendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "notFound") {
+			return nil, NewNotFoundError(ErrCodeEventNotFound, fmt.Sprintf("Event not found: %s", eventID))
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve event", err)
+	}
+
+	if event.Status == EventStatusCancelled {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Cancelled events cannot be rescheduled", "")
+	}
+	if len(event.Recurrence) > 0 {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Recurring series cannot be rescheduled as a whole",
+			"Reschedule a single occurrence using its instance ID from list_calendar_events")
+	}
+	if event.Start == nil || event.Start.DateTime == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "All-day events cannot be rescheduled", "")
+	}
+
+	return event, nil
+}
+
+// rescheduleAttendees splits an event's attendees into required and
+// optional ones. The calendar owner and attendees who declined are left
+// out; rooms, other resources and an organizer other than the calendar owner
+// are required.
+func rescheduleAttendees(event *calendar.Event) ([]string, []string) {
+	var required, optional []string
+	if event.Organizer != nil && !event.Organizer.Self && event.Organizer.Email != "" {
+		required = append(required, event.Organizer.Email)
+	}
+	for _, attendee := range event.Attendees {
+		if attendee.Organizer && containsFold(required, attendee.Email) {
+			continue
+		}
+		if attendee.Self || attendee.Email == "" || attendee.ResponseStatus == ResponseStatusDeclined {
+			continue
+		}
+		if attendee.Optional {
+			optional = append(optional, attendee.Email)
+		} else {
+			required = append(required, attendee.Email)
+		}
+	}
+	return required, optional
+}
@@ -0,0 +1,193 @@
+package calendar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// loadRoomCatalog reads a room catalog from an inline JSON array or a JSON file path
+func loadRoomCatalog(value string) ([]Room, error) {
+	data := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read room catalog file: %w", err)
+		}
+		data = content
+	}
+
+	var rooms []Room
+	if err := json.Unmarshal(data, &rooms); err != nil {
+		return nil, fmt.Errorf("invalid room catalog JSON: %w", err)
+	}
+
+	for i, room := range rooms {
+		if strings.TrimSpace(room.Email) == "" {
+			return nil, fmt.Errorf("room %d has no email", i+1)
+		}
+		if room.Name == "" {
+			rooms[i].Name = room.Email
+		}
+	}
+
+	return rooms, nil
+}
+
+// ListRooms returns the rooms matching the request, from the configured room
+// catalog or else the Workspace directory. If the request has a time range,
+// rooms are checked with free/busy and only those known to be free are kept.
+func (s *googleCalendarService) ListRooms(ctx context.Context, req *RoomSearchRequest) ([]Room, error) {
+	checkFree := !req.StartTime.IsZero() || !req.EndTime.IsZero()
+	if checkFree && !req.StartTime.Before(req.EndTime) {
+		return nil, NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+	if req.MinCapacity < 0 {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Minimum capacity cannot be negative", "")
+	}
+
+	rooms := s.config.Rooms
+	if rooms == nil {
+		directoryRooms, err := s.listDirectoryRooms(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rooms = directoryRooms
+	}
+
+	matching := FilterRooms(rooms, req)
+	if !checkFree || len(matching) == 0 {
+		return matching, nil
+	}
+
+	free := []Room{}
+	for start := 0; start < len(matching); start += MaxFreeBusyItems {
+		batch := matching[start:min(start+MaxFreeBusyItems, len(matching))]
+
+		calendarIDs := make([]string, len(batch))
+		for i, room := range batch {
+			calendarIDs[i] = room.Email
+		}
+
+		freeBusy, err := s.QueryFreeBusy(ctx, &FreeBusyRequest{StartTime: req.StartTime, EndTime: req.EndTime, CalendarIDs: calendarIDs})
+		if err != nil {
+			return nil, err
+		}
+
+		busy := make(map[string]bool)
+		for _, conflict := range overlappingBusy(freeBusy, req.StartTime, req.EndTime) {
+			busy[strings.ToLower(conflict.CalendarID)] = true
+		}
+		for _, cal := range freeBusy.Calendars {
+			if len(cal.Errors) > 0 {
+				busy[strings.ToLower(cal.CalendarID)] = true
+			}
+		}
+
+		for _, room := range batch {
+			if !busy[strings.ToLower(room.Email)] {
+				free = append(free, room)
+			}
+		}
+	}
+
+	return free, nil
+}
+
+// FilterRooms returns the rooms with at least the minimum capacity, in the
+// building (if given) and with all of the features. Building and feature
+// names are matched case-insensitively.
+func FilterRooms(rooms []Room, req *RoomSearchRequest) []Room {
+	matching := []Room{}
+	for _, room := range rooms {
+		if room.Capacity < req.MinCapacity {
+			continue
+		}
+		if req.Building != "" && !strings.EqualFold(room.Building, req.Building) {
+			continue
+		}
+		if !hasAllFeatures(room, req.Features) {
+			continue
+		}
+		matching = append(matching, room)
+	}
+	return matching
+}
+
+// hasAllFeatures reports whether a room has every one of the features
+func hasAllFeatures(room Room, features []string) bool {
+	for _, feature := range features {
+		found := false
+		for _, roomFeature := range room.Features {
+			if strings.EqualFold(roomFeature, feature) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// listDirectoryRooms reads the Workspace calendar resources from the Directory API
+func (s *googleCalendarService) listDirectoryRooms(ctx context.Context) ([]Room, error) {
+	service, err := s.authManager.GetDirectoryService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rooms []Room
+	err = service.Resources.Calendars.List("my_customer").
+		MaxResults(500).
+		Pages(ctx, func(page *admin.CalendarResources) error {
+			for _, resource := range page.Items {
+				if resource.ResourceEmail != "" {
+					rooms = append(rooms, convertCalendarResource(resource))
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") || strings.Contains(err.Error(), "unauthorized") {
+			return nil, NewPermissionError(ErrCodePermissionDenied,
+				fmt.Sprintf("Permission denied to read Workspace resources as %s", s.config.DirectoryAdmin))
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to list Workspace resources", err)
+	}
+
+	return rooms, nil
+}
+
+// convertCalendarResource converts a Directory API calendar resource to our Room struct
+func convertCalendarResource(resource *admin.CalendarResource) Room {
+	room := Room{
+		Email:       resource.ResourceEmail,
+		Name:        resource.GeneratedResourceName,
+		Building:    resource.BuildingId,
+		Floor:       resource.FloorName,
+		Capacity:    int(resource.Capacity),
+		Description: resource.UserVisibleDescription,
+	}
+	if room.Name == "" {
+		room.Name = resource.ResourceName
+	}
+
+	// Feature instances are returned untyped as [{"feature": {"name": "..."}}]
+	if instances, ok := resource.FeatureInstances.([]interface{}); ok {
+		for _, instance := range instances {
+			fields, _ := instance.(map[string]interface{})
+			feature, _ := fields["feature"].(map[string]interface{})
+			if name, ok := feature["name"].(string); ok && name != "" {
+				room.Features = append(room.Features, name)
+			}
+		}
+	}
+
+	return room
+}
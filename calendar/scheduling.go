@@ -0,0 +1,381 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdayNames maps lower-case day names and abbreviations to weekdays
+var weekdayNames = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// SuggestMeetingTimes finds and ranks meeting slots in which the configured
+// calendar and all attendees are free, subject to the request's constraints
+func (s *googleCalendarService) SuggestMeetingTimes(ctx context.Context, req *MeetingSuggestionRequest) (*MeetingSuggestionResult, error) {
+	if err := validateMeetingSuggestionRequest(req); err != nil {
+		return nil, err
+	}
+
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = s.config.TimeZone
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid timezone: %s", timeZone), err.Error())
+	}
+
+	freeBusy, err := s.QueryFreeBusy(ctx, &FreeBusyRequest{
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		CalendarIDs: append(append([]string{s.config.CalendarID}, req.Attendees...), req.OptionalAttendees...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Moving != nil {
+		service, err := s.authManager.GetCalendarService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.excludeMovedEvent(ctx, service, freeBusy, req.Moving); err != nil {
+			return nil, err
+		}
+	}
+
+	// Without an explicit range, each person's configured working hours apply
+	// unless the caller gave theirs. The caller's request is left unchanged.
+	ranked := *req
+	if req.WorkingHours == nil {
+		ranked.AttendeeHours = make(map[string]*WorkingHours)
+		for _, calendarBusy := range freeBusy.Calendars {
+			if hours, ok := req.AttendeeHours[calendarBusy.CalendarID]; ok {
+				ranked.AttendeeHours[calendarBusy.CalendarID] = hours
+				continue
+			}
+			hours, err := s.config.WorkingHoursFor(calendarBusy.CalendarID)
+			if err != nil {
+				return nil, NewInvalidInputError(ErrCodeConfigurationError, "Invalid working hours", err.Error())
+			}
+			ranked.AttendeeHours[calendarBusy.CalendarID] = hours
+		}
+	}
+
+	return RankMeetingSlots(&ranked, freeBusy, loc), nil
+}
+
+// RankMeetingSlots generates candidate slots from free/busy information and
+// ranks them. Candidates start on multiples of MeetingSlotStep after local
+// midnight in loc and must fit the working hours (or, if unset, each
+// attendee's AttendeeHours), the buffers and the daily cap on busy blocks.
+// They are scored on preferred days and times, how busy the day already is,
+// how soon they are and whether optional attendees are free. The returned
+// suggestions do not overlap each other.
+func RankMeetingSlots(req *MeetingSuggestionRequest, freeBusy *FreeBusyResult, loc *time.Location) *MeetingSuggestionResult {
+	result := &MeetingSuggestionResult{Suggestions: []MeetingSuggestion{}}
+
+	// Widen busy blocks so that a free gap leaves room for the buffers, and
+	// record the busiest attendee's number of busy blocks per day. Free/busy
+	// merges back-to-back and overlapping meetings, so each run counts once.
+	var busy []TimeSlot
+	var optionalBusy []CalendarBusy
+	dayBusyBlocks := make(map[string]int)
+	knownCalendars := 0
+	for _, calendarBusy := range freeBusy.Calendars {
+		if len(calendarBusy.Errors) > 0 {
+			result.UnknownAvailability = append(result.UnknownAvailability, calendarBusy.CalendarID)
+			continue
+		}
+
+		blocks := calendarBusy.Busy
+
+		// Optional attendees only affect the score
+		if isOptionalAttendee(req, calendarBusy.CalendarID) {
+			optionalBusy = append(optionalBusy, CalendarBusy{CalendarID: calendarBusy.CalendarID, Busy: blocks})
+			continue
+		}
+		knownCalendars++
+
+		perDay := make(map[string]int)
+		for _, block := range blocks {
+			busy = append(busy, TimeSlot{
+				Start: block.Start.Add(-req.BufferAfter),
+				End:   block.End.Add(req.BufferBefore),
+			})
+			perDay[block.Start.In(loc).Format("2006-01-02")]++
+		}
+		for day, count := range perDay {
+			if count > dayBusyBlocks[day] {
+				dayBusyBlocks[day] = count
+			}
+		}
+
+		// Time outside a person's working hours counts as busy, without buffers
+		if hours := req.AttendeeHours[calendarBusy.CalendarID]; req.WorkingHours == nil && hours != nil {
+			busy = append(busy, FreeSlotsBetween(req.StartTime, req.EndTime, hours.Intervals(req.StartTime, req.EndTime))...)
+		}
+	}
+
+	var candidates []MeetingSuggestion
+	for _, free := range FreeSlotsBetween(req.StartTime, req.EndTime, busy) {
+		// Align to the step from local midnight rather than from the UTC epoch
+		day := free.Start.In(loc)
+		midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+		start := midnight.Add((free.Start.Sub(midnight) + MeetingSlotStep - 1) / MeetingSlotStep * MeetingSlotStep)
+
+		for ; !start.Add(req.Duration).After(free.End); start = start.Add(MeetingSlotStep) {
+			local := start.In(loc)
+			if req.WorkingHours != nil && !fitsTimeOfDay(local, req.Duration, *req.WorkingHours) {
+				continue
+			}
+			busyBlocks := dayBusyBlocks[local.Format("2006-01-02")]
+			if req.MaxMeetingsPerDay > 0 && busyBlocks >= req.MaxMeetingsPerDay {
+				continue
+			}
+			candidates = append(candidates, scoreMeetingSlot(req, start, loc, busyBlocks, knownCalendars, optionalBusy))
+		}
+	}
+	result.CandidatesEvaluated = len(candidates)
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Start.Before(candidates[j].Start)
+	})
+
+	maxSuggestions := req.MaxSuggestions
+	if maxSuggestions <= 0 {
+		maxSuggestions = DefaultMaxSuggestions
+	}
+	for _, candidate := range candidates {
+		if len(result.Suggestions) == maxSuggestions {
+			break
+		}
+		if !overlapsSuggestion(result.Suggestions, candidate) {
+			result.Suggestions = append(result.Suggestions, candidate)
+		}
+	}
+
+	return result
+}
+
+// scoreMeetingSlot scores a candidate slot out of 100 and explains the score
+func scoreMeetingSlot(req *MeetingSuggestionRequest, start time.Time, loc *time.Location, busyBlocks, knownCalendars int, optionalBusy []CalendarBusy) MeetingSuggestion {
+	local := start.In(loc)
+	suggestion := MeetingSuggestion{
+		Start:   start,
+		End:     start.Add(req.Duration),
+		Reasons: []string{fmt.Sprintf("All %d checked calendars are free", knownCalendars)},
+	}
+	score := 50.0
+
+	if len(req.PreferredDays) > 0 {
+		for _, day := range req.PreferredDays {
+			if local.Weekday() == day {
+				score += 20
+				suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("Falls on a preferred day (%s)", day))
+				break
+			}
+		}
+	}
+
+	if len(req.PreferredTimes) > 0 {
+		for _, preferred := range req.PreferredTimes {
+			if fitsTimeOfDay(local, req.Duration, preferred) {
+				score += 20
+				suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("Within preferred time %s", preferred))
+				break
+			}
+		}
+	}
+
+	// Lighter days score higher, up to 10 points for a day with no other busy time
+	score += 10 - 2*math.Min(float64(busyBlocks), 5)
+	switch busyBlocks {
+	case 0:
+		suggestion.Reasons = append(suggestion.Reasons, "No other busy time that day")
+	case 1:
+		suggestion.Reasons = append(suggestion.Reasons, "Only 1 other busy block that day")
+	default:
+		suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("%d other busy blocks that day", busyBlocks))
+	}
+
+	// Sooner slots score higher, up to 10 points at the start of the window
+	window := req.EndTime.Sub(req.StartTime)
+	earliness := 1 - float64(start.Sub(req.StartTime))/float64(window)
+	score += 10 * earliness
+	if earliness >= 0.75 {
+		suggestion.Reasons = append(suggestion.Reasons, "Early in the search window")
+	}
+
+	if req.BufferBefore > 0 || req.BufferAfter > 0 {
+		suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("Leaves %d min before and %d min after free",
+			int(req.BufferBefore.Minutes()), int(req.BufferAfter.Minutes())))
+	}
+
+	// Busy optional attendees cost up to 15 points
+	if len(optionalBusy) > 0 {
+		var busyOptional []string
+		for _, calendarBusy := range optionalBusy {
+			for _, block := range calendarBusy.Busy {
+				if block.Start.Before(suggestion.End) && block.End.After(suggestion.Start) {
+					busyOptional = append(busyOptional, calendarBusy.CalendarID)
+					break
+				}
+			}
+		}
+		if len(busyOptional) == 0 {
+			suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("All %d optional attendees are free", len(optionalBusy)))
+		} else {
+			score -= 15 * float64(len(busyOptional)) / float64(len(optionalBusy))
+			suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("Optional attendees busy: %s", strings.Join(busyOptional, ", ")))
+		}
+	}
+
+	suggestion.Score = math.Round(math.Max(0, math.Min(100, score))*10) / 10
+	return suggestion
+}
+
+// isOptionalAttendee reports whether a calendar belongs only to optional attendees
+func isOptionalAttendee(req *MeetingSuggestionRequest, calendarID string) bool {
+	for _, attendee := range req.Attendees {
+		if strings.EqualFold(attendee, calendarID) {
+			return false
+		}
+	}
+	for _, attendee := range req.OptionalAttendees {
+		if strings.EqualFold(attendee, calendarID) {
+			return true
+		}
+	}
+	return false
+}
+
+// fitsTimeOfDay reports whether a slot starting at local lies within the daily range on the same day
+func fitsTimeOfDay(local time.Time, duration time.Duration, r TimeOfDayRange) bool {
+	startMinute := local.Hour()*60 + local.Minute()
+	endMinute := startMinute + int(math.Ceil(duration.Minutes()))
+	return startMinute >= r.Start && endMinute <= r.End
+}
+
+// overlapsSuggestion reports whether candidate overlaps any chosen suggestion
+func overlapsSuggestion(chosen []MeetingSuggestion, candidate MeetingSuggestion) bool {
+	for _, suggestion := range chosen {
+		if candidate.Start.Before(suggestion.End) && suggestion.Start.Before(candidate.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMeetingSuggestionRequest validates a meeting suggestion request
+func validateMeetingSuggestionRequest(req *MeetingSuggestionRequest) error {
+	if !req.StartTime.Before(req.EndTime) {
+		return NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+
+	if req.Duration <= 0 || req.Duration > 24*time.Hour {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Duration must be between 1 minute and 24 hours", "")
+	}
+
+	if req.BufferBefore < 0 || req.BufferAfter < 0 {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Buffers cannot be negative", "")
+	}
+
+	if req.MaxMeetingsPerDay < 0 {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Maximum meetings per day cannot be negative", "")
+	}
+
+	if attendees := len(req.Attendees) + len(req.OptionalAttendees); attendees >= MaxFreeBusyItems {
+		return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Too many attendees: %d", attendees),
+			fmt.Sprintf("At most %d attendees can be checked at once", MaxFreeBusyItems-1))
+	}
+
+	return nil
+}
+
+// String formats the range as "09:00-17:00"
+func (r TimeOfDayRange) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", r.Start/60, r.Start%60, r.End/60, r.End%60)
+}
+
+// parseTimeOfDayRange parses a range such as "09:00-17:00". "24:00" may be used as the end.
+func parseTimeOfDayRange(value string) (TimeOfDayRange, error) {
+	startStr, endStr, found := strings.Cut(strings.TrimSpace(value), "-")
+	if !found {
+		return TimeOfDayRange{}, fmt.Errorf("invalid time range %q, expected HH:MM-HH:MM", value)
+	}
+
+	start, err := parseClockMinutes(startStr)
+	if err != nil {
+		return TimeOfDayRange{}, err
+	}
+	end, err := parseClockMinutes(endStr)
+	if err != nil {
+		return TimeOfDayRange{}, err
+	}
+	if start >= end {
+		return TimeOfDayRange{}, fmt.Errorf("invalid time range %q: start must be before end", value)
+	}
+
+	return TimeOfDayRange{Start: start, End: end}, nil
+}
+
+// parseTimeOfDayRanges parses a comma-separated list of time ranges
+func parseTimeOfDayRanges(value string) ([]TimeOfDayRange, error) {
+	var ranges []TimeOfDayRange
+	for _, part := range splitAndTrim(value) {
+		r, err := parseTimeOfDayRange(part)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// parseClockMinutes parses "HH:MM" into minutes after midnight
+func parseClockMinutes(value string) (int, error) {
+	hourStr, minuteStr, found := strings.Cut(strings.TrimSpace(value), ":")
+	if !found {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	minute, err := strconv.Atoi(minuteStr)
+	if err != nil || minute < 0 || minute > 59 || hour < 0 || hour > 24 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+
+	return hour*60 + minute, nil
+}
+
+// parseWeekdays parses a comma-separated list of day names such as "mon,tuesday"
+func parseWeekdays(value string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, name := range splitAndTrim(value) {
+		day, ok := weekdayNames[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q", name)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
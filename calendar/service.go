@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/api/calendar/v3"
@@ -14,21 +16,62 @@ import (
 // CalendarService defines the interface for calendar operations
 type CalendarService interface {
 	// Core operations
-	CheckAvailability(ctx context.Context, startTime, endTime time.Time) ([]TimeSlot, error)
+	CheckAvailability(ctx context.Context, req *AvailabilityRequest) ([]TimeSlot, error)
+	QueryFreeBusy(ctx context.Context, req *FreeBusyRequest) (*FreeBusyResult, error)
+	SuggestMeetingTimes(ctx context.Context, req *MeetingSuggestionRequest) (*MeetingSuggestionResult, error)
+	FindConflicts(ctx context.Context, req *ConflictReportRequest) (*ConflictReport, error)
+	ListRooms(ctx context.Context, req *RoomSearchRequest) ([]Room, error)
 	CreateEvent(ctx context.Context, event *EventCreateRequest) (*Event, error)
-	ListEvents(ctx context.Context, timeMin, timeMax time.Time) ([]*Event, error)
+	QuickAddEvent(ctx context.Context, text string) (*Event, error)
+	ListEvents(ctx context.Context, req *ListEventsRequest) ([]*Event, error)
+	GetEvent(ctx context.Context, eventID string) (*Event, error)
 	UpdateEvent(ctx context.Context, eventID string, update *EventUpdateRequest) (*Event, error)
 	DeleteEvent(ctx context.Context, eventID string) error
+	MoveEvent(ctx context.Context, eventID, destinationCalendarID string) (*Event, error)
 
 	// Utility operations
 	GetCalendarInfo(ctx context.Context) (*CalendarInfo, error)
-	SearchEvents(ctx context.Context, query string, timeMin, timeMax time.Time) ([]*Event, error)
+	GetSettings(ctx context.Context) (*CalendarSettings, error)
+	SearchEvents(ctx context.Context, req *SearchRequest) ([]*Event, error)
+	ListEventColors(ctx context.Context) ([]EventColor, error)
+
+	// Calendar list management
+	ListCalendars(ctx context.Context, showHidden bool) ([]*CalendarEntry, error)
+	CreateCalendar(ctx context.Context, req *CalendarCreateRequest) (*CalendarInfo, error)
+	UpdateCalendar(ctx context.Context, calendarID string, update *CalendarUpdateRequest) (*CalendarInfo, error)
+	DeleteCalendar(ctx context.Context, calendarID string) error
+	SubscribeCalendar(ctx context.Context, calendarID string) (*CalendarEntry, error)
+	UnsubscribeCalendar(ctx context.Context, calendarID string) error
+
+	// Calendar sharing
+	ListACL(ctx context.Context, calendarID string) ([]*ACLRule, error)
+	ShareCalendar(ctx context.Context, req *ShareCalendarRequest) (*ACLRule, error)
+	UnshareCalendar(ctx context.Context, calendarID, ruleID string) error
+
+	// Special event types
+	CreateFocusTime(ctx context.Context, req *FocusTimeRequest) (*Event, error)
+	CreateOutOfOffice(ctx context.Context, req *OutOfOfficeRequest) (*Event, error)
+	SetWorkingLocation(ctx context.Context, req *WorkingLocationRequest) (*Event, error)
+
+	// iCalendar interchange
+	ImportICS(ctx context.Context, data string) (*ImportResult, error)
+	ExportICS(ctx context.Context, startTime, endTime time.Time) (string, error)
+
+	// Rescheduling
+	ProposeReschedule(ctx context.Context, req *RescheduleRequest) (*RescheduleProposal, error)
+	RescheduleEvent(ctx context.Context, eventID string, newStartTime time.Time, allowConflicts bool) (*Event, error)
+
+	// Appointment booking
+	ListBookableSlots(ctx context.Context, appointmentType string, startTime, endTime time.Time) ([]TimeSlot, error)
+	BookSlot(ctx context.Context, req *BookingRequest) (*Event, error)
 }
 
 // googleCalendarService implements CalendarService using Google Calendar API
 type googleCalendarService struct {
 	authManager *AuthManager
 	config      *CalendarConfig
+	// bookingMu serialises appointment bookings made through this server
+	bookingMu sync.Mutex
 }
 
 // NewCalendarService creates a new calendar service
@@ -41,6 +84,13 @@ func NewCalendarService(config *CalendarConfig) (CalendarService, error) {
 		return nil, fmt.Errorf("failed to validate credentials: %w", err)
 	}
 
+	// Prefer the account's own timezone unless one was configured explicitly
+	if !config.TimeZoneExplicit {
+		if err := applyAccountTimeZone(ctx, authManager, config); err != nil {
+			log.Printf("Warning: Failed to read account timezone, using %s: %v", config.TimeZone, err)
+		}
+	}
+
 	return &googleCalendarService{
 		authManager: authManager,
 		config:      config,
@@ -48,9 +98,20 @@ func NewCalendarService(config *CalendarConfig) (CalendarService, error) {
 }
 
 // CheckAvailability checks for available time slots in the given time range
-func (s *googleCalendarService) CheckAvailability(ctx context.Context, startTime, endTime time.Time) ([]TimeSlot, error) {
-	if startTime.After(endTime) {
-		return nil, NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+func (s *googleCalendarService) CheckAvailability(ctx context.Context, req *AvailabilityRequest) ([]TimeSlot, error) {
+	if err := validateAvailabilityRequest(req); err != nil {
+		return nil, err
+	}
+
+	calendarID := s.calendarIDOrDefault(req.CalendarID)
+
+	var workingHours *WorkingHours
+	if req.WorkingHoursOnly {
+		hours, err := s.config.WorkingHoursFor(calendarID)
+		if err != nil {
+			return nil, NewInvalidInputError(ErrCodeConfigurationError, "Invalid working hours", err.Error())
+		}
+		workingHours = hours
 	}
 
 	service, err := s.authManager.GetCalendarService(ctx)
@@ -58,21 +119,36 @@ func (s *googleCalendarService) CheckAvailability(ctx context.Context, startTime
 		return nil, err
 	}
 
-	// Get events in the time range
-	events, err := service.Events.List(s.config.CalendarID).
-		TimeMin(startTime.Format(time.RFC3339)).
-		TimeMax(endTime.Format(time.RFC3339)).
+	// Get every event in the time range, which may span several pages
+	var events []*calendar.Event
+	err = service.Events.List(calendarID).
+		TimeMin(req.StartTime.Format(time.RFC3339)).
+		TimeMax(req.EndTime.Format(time.RFC3339)).
 		SingleEvents(true).
 		OrderBy("startTime").
-		Context(ctx).
-		Do()
+		MaxResults(2500).
+		Pages(ctx, func(page *calendar.Events) error {
+			events = append(events, page.Items...)
+			return nil
+		})
+	if err != nil {
+		return nil, mapCalendarError(err, calendarID, "retrieve events from")
+	}
 
+	// Convert events to busy slots and find the free time between them
+	busy, err := BusySlotsFromEvents(events, req.BusyRules, s.location())
 	if err != nil {
-		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve events", err)
+		return nil, err
+	}
+	timeSlots := CalculateFreeTimeSlots(req.StartTime, req.EndTime, busy)
+
+	// Bookable slots are aligned in the working hours' timezone, or the calendar's
+	loc := s.location()
+	if workingHours != nil {
+		loc = workingHours.Location
 	}
 
-	// Convert events to time slots and find free slots
-	return s.calculateFreeTimeSlots(startTime, endTime, events.Items), nil
+	return ApplyAvailabilityOptions(timeSlots, req, workingHours, loc), nil
 }
 
 // CreateEvent creates a new calendar event
@@ -88,9 +164,13 @@ func (s *googleCalendarService) CreateEvent(ctx context.Context, eventReq *Event
 
 	// Convert our event to Google Calendar event
 	googleEvent := &calendar.Event{
-		Summary:     eventReq.Summary,
-		Description: eventReq.Description,
-		Location:    eventReq.Location,
+		Summary:      eventReq.Summary,
+		Description:  eventReq.Description,
+		Location:     eventReq.Location,
+		Visibility:   eventReq.Visibility,
+		Transparency: eventReq.Transparency,
+		ColorId:      eventReq.ColorID,
+		Status:       eventReq.Status,
 		Start: &calendar.EventDateTime{
 			DateTime: eventReq.StartTime.Format(time.RFC3339),
 			TimeZone: s.config.TimeZone,
@@ -111,7 +191,43 @@ func (s *googleCalendarService) CreateEvent(ctx context.Context, eventReq *Event
 		}
 	}
 
-	createdEvent, err := service.Events.Insert(s.config.CalendarID, googleEvent).Context(ctx).Do()
+	// Rooms are invited as resource attendees
+	for _, room := range eventReq.Rooms {
+		googleEvent.Attendees = append(googleEvent.Attendees, &calendar.EventAttendee{
+			Email:    room,
+			Resource: true,
+		})
+	}
+
+	// Add extended properties if provided
+	if props := eventReq.ExtendedProperties; props != nil && (len(props.Private) > 0 || len(props.Shared) > 0) {
+		googleEvent.ExtendedProperties = &calendar.EventExtendedProperties{
+			Private: props.Private,
+			Shared:  props.Shared,
+		}
+	}
+
+	// Add attachments if provided
+	if len(eventReq.Attachments) > 0 {
+		googleEvent.Attachments = convertAttachmentsToGoogle(eventReq.Attachments)
+	}
+
+	// Add reminder overrides if provided
+	if len(eventReq.Reminders) > 0 {
+		googleEvent.Reminders = convertRemindersToGoogle(eventReq.Reminders)
+	}
+
+	if !eventReq.AllowConflicts && IsBusyEvent(googleEvent, BusyRules{}) {
+		attendees := append(append([]string{}, eventReq.Attendees...), eventReq.Rooms...)
+		if err := s.checkEventConflicts(ctx, service, eventReq.StartTime, eventReq.EndTime, attendees, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	createdEvent, err := service.Events.Insert(s.config.CalendarID, googleEvent).
+		SupportsAttachments(true).
+		Context(ctx).
+		Do()
 	if err != nil {
 		if strings.Contains(err.Error(), "forbidden") {
 			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to create event")
@@ -122,36 +238,113 @@ func (s *googleCalendarService) CreateEvent(ctx context.Context, eventReq *Event
 	return s.convertGoogleEventToEvent(createdEvent), nil
 }
 
-// ListEvents retrieves events in the specified time range
-func (s *googleCalendarService) ListEvents(ctx context.Context, timeMin, timeMax time.Time) ([]*Event, error) {
-	if timeMin.After(timeMax) {
+// QuickAddEvent creates an event from a natural language description such as
+// "Lunch with Sam tomorrow 12pm at Cafe X"
+func (s *googleCalendarService) QuickAddEvent(ctx context.Context, text string) (*Event, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Event text is required", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdEvent, err := service.Events.QuickAdd(s.config.CalendarID, text).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to create event")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to quick-add event", err)
+	}
+
+	return s.convertGoogleEventToEvent(createdEvent), nil
+}
+
+// ListEvents retrieves events in the specified time range.
+// Deleted events and event types are filtered by Google; declined,
+// organizer and attendee filters are applied locally.
+func (s *googleCalendarService) ListEvents(ctx context.Context, req *ListEventsRequest) ([]*Event, error) {
+	if req.StartTime.After(req.EndTime) {
 		return nil, NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
 	}
 
+	if err := validatePropertyFilter(req.PropertyFilter); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventTypes(req.EventTypes); err != nil {
+		return nil, err
+	}
+
 	service, err := s.authManager.GetCalendarService(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	googleEvents, err := service.Events.List(s.config.CalendarID).
-		TimeMin(timeMin.Format(time.RFC3339)).
-		TimeMax(timeMax.Format(time.RFC3339)).
+	maxResults := maxResultsOrDefault(req.MaxResults)
+	call := service.Events.List(s.config.CalendarID).
+		TimeMin(req.StartTime.Format(time.RFC3339)).
+		TimeMax(req.EndTime.Format(time.RFC3339)).
 		SingleEvents(true).
 		OrderBy("startTime").
-		MaxResults(int64(DefaultMaxResults)).
-		Context(ctx).
-		Do()
+		ShowDeleted(req.ShowDeleted).
+		MaxResults(int64(maxResults)).
+		Context(ctx)
+	call = applyPropertyFilter(call, req.PropertyFilter)
+
+	if len(req.EventTypes) > 0 {
+		call = call.EventTypes(req.EventTypes...)
+	}
+
+	// Some filters are applied here rather than by Google, so keep reading
+	// pages until enough events pass them or the range is exhausted
+	events := []*Event{}
+	for {
+		googleEvents, err := call.Do()
+		if err != nil {
+			return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve events", err)
+		}
+
+		for _, googleEvent := range FilterListedEvents(googleEvents.Items, req, maxResults-len(events)) {
+			events = append(events, s.convertGoogleEventToEvent(googleEvent))
+		}
+
+		if googleEvents.NextPageToken == "" || len(events) == maxResults {
+			return events, nil
+		}
+		call = call.PageToken(googleEvents.NextPageToken)
+	}
+}
+
+// GetEvent retrieves a single event by ID
+func (s *googleCalendarService) GetEvent(ctx context.Context, eventID string) (*Event, error) {
+	if eventID == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Event ID is required", "")
+	}
 
+	service, err := s.authManager.GetCalendarService(ctx)
 	if err != nil {
-		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve events", err)
+		return nil, err
 	}
 
-	events := make([]*Event, len(googleEvents.Items))
-	for i, googleEvent := range googleEvents.Items {
-		events[i] = s.convertGoogleEventToEvent(googleEvent)
+	googleEvent, err := service.Events.Get(s.config.CalendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "notFound") || strings.Contains(err.Error(), "deleted") {
+			return nil, NewNotFoundError(ErrCodeEventNotFound, fmt.Sprintf("Event not found: %s", eventID))
+		}
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to read event")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve event", err)
 	}
 
-	return events, nil
+	// Deleted events can still be fetched by ID and come back cancelled
+	if googleEvent.Status == EventStatusCancelled {
+		return nil, NewNotFoundError(ErrCodeEventNotFound, fmt.Sprintf("Event has been deleted: %s", eventID))
+	}
+
+	return s.convertGoogleEventToEvent(googleEvent), nil
 }
 
 // UpdateEvent updates an existing calendar event
@@ -160,6 +353,23 @@ func (s *googleCalendarService) UpdateEvent(ctx context.Context, eventID string,
 		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Event ID is required", "")
 	}
 
+	if err := ValidateReminders(update.Reminders); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateExtendedProperties(update.ExtendedProperties); err != nil {
+		return nil, err
+	}
+
+	if err := validateAttachments(update.Attachments); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventProperties(derefString(update.Visibility), derefString(update.Transparency),
+		derefString(update.ColorID), derefString(update.Status)); err != nil {
+		return nil, err
+	}
+
 	service, err := s.authManager.GetCalendarService(ctx)
 	if err != nil {
 		return nil, err
@@ -174,11 +384,41 @@ func (s *googleCalendarService) UpdateEvent(ctx context.Context, eventID string,
 		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve event", err)
 	}
 
+	previousStart, _ := s.parseEventDateTime(existingEvent.Start)
+	previousEnd, _ := s.parseEventDateTime(existingEvent.End)
+	moved := &MovedEvent{
+		EventID:   eventID,
+		Previous:  TimeSlot{Start: previousStart, End: previousEnd},
+		Calendars: movedEventCalendars(s.config.CalendarID, existingEvent),
+	}
+
 	// Apply updates
 	s.applyEventUpdates(existingEvent, update)
 
+	// Only new times or attendees can introduce a conflict
+	rescheduled := update.StartTime != nil || update.EndTime != nil || update.Attendees != nil
+	if rescheduled && !update.AllowConflicts && IsBusyEvent(existingEvent, BusyRules{}) {
+		startTime, _ := s.parseEventDateTime(existingEvent.Start)
+		endTime, _ := s.parseEventDateTime(existingEvent.End)
+
+		// The calendar owner's own entry is covered by reading the configured calendar
+		var attendees []string
+		for _, attendee := range existingEvent.Attendees {
+			if !attendee.Self {
+				attendees = append(attendees, attendee.Email)
+			}
+		}
+
+		if err := s.checkEventConflicts(ctx, service, startTime, endTime, attendees, existingEvent.Organizer, moved); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update the event
-	updatedEvent, err := service.Events.Update(s.config.CalendarID, eventID, existingEvent).Context(ctx).Do()
+	updatedEvent, err := service.Events.Update(s.config.CalendarID, eventID, existingEvent).
+		SupportsAttachments(true).
+		Context(ctx).
+		Do()
 	if err != nil {
 		if strings.Contains(err.Error(), "forbidden") {
 			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to update event")
@@ -215,35 +455,78 @@ func (s *googleCalendarService) DeleteEvent(ctx context.Context, eventID string)
 	return nil
 }
 
+// MoveEvent moves an event to another calendar, keeping its ID, attendees and history
+func (s *googleCalendarService) MoveEvent(ctx context.Context, eventID, destinationCalendarID string) (*Event, error) {
+	if eventID == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Event ID is required", "")
+	}
+
+	if destinationCalendarID == "" {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Destination calendar ID is required", "")
+	}
+
+	if destinationCalendarID == s.config.CalendarID {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Destination calendar must differ from the source calendar", "")
+	}
+
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	movedEvent, err := service.Events.Move(s.config.CalendarID, eventID, destinationCalendarID).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "notFound") {
+			return nil, NewNotFoundError(ErrCodeEventNotFound,
+				fmt.Sprintf("Event %s or destination calendar %s not found", eventID, destinationCalendarID))
+		}
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to move event")
+		}
+		if strings.Contains(err.Error(), "cannotChangeOrganizer") {
+			return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Only the organizer's copy of an event can be moved", err.Error())
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to move event", err)
+	}
+
+	log.Printf("Successfully moved event %s to calendar %s", eventID, destinationCalendarID)
+	return s.convertGoogleEventToEvent(movedEvent), nil
+}
+
 // GetCalendarInfo retrieves basic calendar information
 func (s *googleCalendarService) GetCalendarInfo(ctx context.Context) (*CalendarInfo, error) {
 	return s.authManager.GetCalendarInfo(ctx)
 }
 
 // SearchEvents searches for events matching the given query
-func (s *googleCalendarService) SearchEvents(ctx context.Context, query string, timeMin, timeMax time.Time) ([]*Event, error) {
-	if query == "" {
+func (s *googleCalendarService) SearchEvents(ctx context.Context, req *SearchRequest) ([]*Event, error) {
+	if req.Query == "" {
 		return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Search query is required", "")
 	}
 
+	if err := validatePropertyFilter(req.PropertyFilter); err != nil {
+		return nil, err
+	}
+
 	service, err := s.authManager.GetCalendarService(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	call := service.Events.List(s.config.CalendarID).
-		Q(query).
+		Q(req.Query).
 		SingleEvents(true).
 		OrderBy("startTime").
-		MaxResults(int64(DefaultMaxResults)).
+		MaxResults(int64(maxResultsOrDefault(req.MaxResults))).
 		Context(ctx)
 
-	if !timeMin.IsZero() {
-		call = call.TimeMin(timeMin.Format(time.RFC3339))
+	if req.StartTime != nil && !req.StartTime.IsZero() {
+		call = call.TimeMin(req.StartTime.Format(time.RFC3339))
 	}
-	if !timeMax.IsZero() {
-		call = call.TimeMax(timeMax.Format(time.RFC3339))
+	if req.EndTime != nil && !req.EndTime.IsZero() {
+		call = call.TimeMax(req.EndTime.Format(time.RFC3339))
 	}
+	call = applyPropertyFilter(call, req.PropertyFilter)
 
 	googleEvents, err := call.Do()
 	if err != nil {
@@ -258,105 +541,353 @@ func (s *googleCalendarService) SearchEvents(ctx context.Context, query string,
 	return events, nil
 }
 
-// Helper methods
+// ListEventColors retrieves the event color palette
+func (s *googleCalendarService) ListEventColors(ctx context.Context) ([]EventColor, error) {
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-// calculateFreeTimeSlots calculates free time slots between events
-func (s *googleCalendarService) calculateFreeTimeSlots(startTime, endTime time.Time, events []*calendar.Event) []TimeSlot {
-	var timeSlots []TimeSlot
+	colors, err := service.Colors.Get().Context(ctx).Do()
+	if err != nil {
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve event colors", err)
+	}
 
-	// If no events, the entire range is free
-	if len(events) == 0 {
-		return []TimeSlot{{
-			Start: startTime,
-			End:   endTime,
-			Free:  true,
-		}}
+	eventColors := make([]EventColor, 0, len(colors.Event))
+	for id, color := range colors.Event {
+		eventColors = append(eventColors, EventColor{
+			ID:         id,
+			Background: color.Background,
+			Foreground: color.Foreground,
+		})
 	}
 
-	// Sort events by start time
-	sort.Slice(events, func(i, j int) bool {
-		iStart, _ := time.Parse(time.RFC3339, events[i].Start.DateTime)
-		jStart, _ := time.Parse(time.RFC3339, events[j].Start.DateTime)
-		return iStart.Before(jStart)
+	// Color IDs are numeric strings, so sort them numerically
+	sort.Slice(eventColors, func(i, j int) bool {
+		iID, _ := strconv.Atoi(eventColors[i].ID)
+		jID, _ := strconv.Atoi(eventColors[j].ID)
+		return iID < jID
 	})
 
-	currentTime := startTime
+	return eventColors, nil
+}
+
+// CreateFocusTime creates a focus time event
+func (s *googleCalendarService) CreateFocusTime(ctx context.Context, req *FocusTimeRequest) (*Event, error) {
+	if err := validateSpecialEventTimes(req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+	if err := validateAutoDeclineMode(req.AutoDeclineMode); err != nil {
+		return nil, err
+	}
+	if req.ChatStatus != "" && req.ChatStatus != ChatStatusAvailable && req.ChatStatus != ChatStatusDoNotDisturb {
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid chat status: %s", req.ChatStatus),
+			"Chat status must be 'available' or 'doNotDisturb'")
+	}
+
+	summary := req.Summary
+	if summary == "" {
+		summary = "Focus time"
+	}
 
-	for _, event := range events {
-		eventStart, _ := time.Parse(time.RFC3339, event.Start.DateTime)
-		eventEnd, _ := time.Parse(time.RFC3339, event.End.DateTime)
+	googleEvent := &calendar.Event{
+		Summary:   summary,
+		EventType: EventTypeFocusTime,
+		Start:     s.eventDateTime(req.StartTime),
+		End:       s.eventDateTime(req.EndTime),
+		FocusTimeProperties: &calendar.EventFocusTimeProperties{
+			AutoDeclineMode: req.AutoDeclineMode,
+			DeclineMessage:  req.DeclineMessage,
+			ChatStatus:      req.ChatStatus,
+		},
+	}
 
-		// Skip events outside our time range
-		if eventEnd.Before(startTime) || eventStart.After(endTime) {
-			continue
-		}
+	return s.insertSpecialEvent(ctx, googleEvent, "focus time")
+}
+
+// CreateOutOfOffice creates an out-of-office event
+func (s *googleCalendarService) CreateOutOfOffice(ctx context.Context, req *OutOfOfficeRequest) (*Event, error) {
+	if err := validateSpecialEventTimes(req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+	if err := validateAutoDeclineMode(req.AutoDeclineMode); err != nil {
+		return nil, err
+	}
+
+	summary := req.Summary
+	if summary == "" {
+		summary = "Out of office"
+	}
+
+	googleEvent := &calendar.Event{
+		Summary:   summary,
+		EventType: EventTypeOutOfOffice,
+		Start:     s.eventDateTime(req.StartTime),
+		End:       s.eventDateTime(req.EndTime),
+		OutOfOfficeProperties: &calendar.EventOutOfOfficeProperties{
+			AutoDeclineMode: req.AutoDeclineMode,
+			DeclineMessage:  req.DeclineMessage,
+		},
+	}
 
-		// Adjust event times to our range
-		if eventStart.Before(startTime) {
-			eventStart = startTime
+	return s.insertSpecialEvent(ctx, googleEvent, "out-of-office")
+}
+
+// SetWorkingLocation creates a working location event
+func (s *googleCalendarService) SetWorkingLocation(ctx context.Context, req *WorkingLocationRequest) (*Event, error) {
+	if err := validateSpecialEventTimes(req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	properties := &calendar.EventWorkingLocationProperties{Type: req.Location.Type}
+	summary := req.Location.Label
+
+	switch req.Location.Type {
+	case WorkingLocationHomeOffice:
+		properties.HomeOffice = map[string]interface{}{}
+		if summary == "" {
+			summary = "Home"
 		}
-		if eventEnd.After(endTime) {
-			eventEnd = endTime
+	case WorkingLocationOffice:
+		properties.OfficeLocation = &calendar.EventWorkingLocationPropertiesOfficeLocation{
+			Label:      req.Location.Label,
+			BuildingId: req.Location.BuildingID,
+			FloorId:    req.Location.FloorID,
+			DeskId:     req.Location.DeskID,
 		}
-
-		// Add free slot before this event
-		if currentTime.Before(eventStart) {
-			timeSlots = append(timeSlots, TimeSlot{
-				Start: currentTime,
-				End:   eventStart,
-				Free:  true,
-			})
+		if summary == "" {
+			summary = "Office"
+		}
+	case WorkingLocationCustom:
+		if req.Location.Label == "" {
+			return nil, NewInvalidInputError(ErrCodeInvalidEventData, "Label is required for a custom working location", "")
 		}
+		properties.CustomLocation = &calendar.EventWorkingLocationPropertiesCustomLocation{
+			Label: req.Location.Label,
+		}
+	default:
+		return nil, NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid working location type: %s", req.Location.Type),
+			"Working location type must be one of: homeOffice, officeLocation, customLocation")
+	}
 
-		// Add busy slot for this event
-		timeSlots = append(timeSlots, TimeSlot{
-			Start: eventStart,
-			End:   eventEnd,
-			Free:  false,
-		})
+	// Working location events must be public and must not block time
+	googleEvent := &calendar.Event{
+		Summary:                   summary,
+		EventType:                 EventTypeWorkingLocation,
+		Visibility:                EventVisibilityPublic,
+		Transparency:              EventTransparencyTransparent,
+		Start:                     s.eventDateTime(req.StartTime),
+		End:                       s.eventDateTime(req.EndTime),
+		WorkingLocationProperties: properties,
+	}
+
+	return s.insertSpecialEvent(ctx, googleEvent, "working location")
+}
+
+// Helper methods
 
-		// Update current time
-		if eventEnd.After(currentTime) {
-			currentTime = eventEnd
+// insertSpecialEvent inserts a focus time, out-of-office or working location event
+func (s *googleCalendarService) insertSpecialEvent(ctx context.Context, googleEvent *calendar.Event, kind string) (*Event, error) {
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdEvent, err := service.Events.Insert(s.config.CalendarID, googleEvent).Context(ctx).Do()
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, fmt.Sprintf("Permission denied to create %s event", kind))
 		}
+		if strings.Contains(err.Error(), "invalid") {
+			return nil, NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Google Calendar rejected the %s event", kind), err.Error())
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, fmt.Sprintf("Failed to create %s event", kind), err)
 	}
 
-	// Add final free slot if needed
-	if currentTime.Before(endTime) {
-		timeSlots = append(timeSlots, TimeSlot{
-			Start: currentTime,
-			End:   endTime,
-			Free:  true,
-		})
+	return s.convertGoogleEventToEvent(createdEvent), nil
+}
+
+// applyAccountTimeZone sets the configured timezone from the account's calendar settings
+func applyAccountTimeZone(ctx context.Context, authManager *AuthManager, config *CalendarConfig) error {
+	service, err := authManager.GetCalendarService(ctx)
+	if err != nil {
+		return err
+	}
+
+	settings, err := fetchSettings(ctx, service)
+	if err != nil {
+		return err
 	}
 
-	return timeSlots
+	if settings.TimeZone == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(settings.TimeZone); err != nil {
+		return fmt.Errorf("unknown account timezone %q: %w", settings.TimeZone, err)
+	}
+
+	log.Printf("Using account timezone from calendar settings: %s", settings.TimeZone)
+	config.TimeZone = settings.TimeZone
+	return nil
+}
+
+// calendarIDOrDefault returns calendarID, or the configured calendar if it is empty
+func (s *googleCalendarService) calendarIDOrDefault(calendarID string) string {
+	if calendarID == "" {
+		return s.config.CalendarID
+	}
+	return calendarID
+}
+
+// eventDateTime converts a time to a Google Calendar date-time in the configured timezone
+func (s *googleCalendarService) eventDateTime(t time.Time) *calendar.EventDateTime {
+	return &calendar.EventDateTime{
+		DateTime: t.Format(time.RFC3339),
+		TimeZone: s.config.TimeZone,
+	}
+}
+
+// parseEventDateTime parses a Google event time, reporting whether it is an
+// all-day date. All-day dates are taken to start at midnight in the calendar's timezone.
+func (s *googleCalendarService) parseEventDateTime(edt *calendar.EventDateTime) (time.Time, bool) {
+	t, allDay, _ := parseEventTime(edt, s.location())
+	return t, allDay
+}
+
+// location returns the calendar's configured timezone, or UTC if it is invalid
+func (s *googleCalendarService) location() *time.Location {
+	loc, err := time.LoadLocation(s.config.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
 }
 
 // convertGoogleEventToEvent converts a Google Calendar event to our Event struct
 func (s *googleCalendarService) convertGoogleEventToEvent(googleEvent *calendar.Event) *Event {
-	startTime, _ := time.Parse(time.RFC3339, googleEvent.Start.DateTime)
-	endTime, _ := time.Parse(time.RFC3339, googleEvent.End.DateTime)
+	startTime, allDay := s.parseEventDateTime(googleEvent.Start)
+	endTime, _ := s.parseEventDateTime(googleEvent.End)
 	createdTime, _ := time.Parse(time.RFC3339, googleEvent.Created)
 	updatedTime, _ := time.Parse(time.RFC3339, googleEvent.Updated)
 
 	var attendees []string
+	var attendeeDetails []Attendee
 	for _, attendee := range googleEvent.Attendees {
 		attendees = append(attendees, attendee.Email)
+		attendeeDetails = append(attendeeDetails, Attendee{
+			Email:          attendee.Email,
+			DisplayName:    attendee.DisplayName,
+			ResponseStatus: attendee.ResponseStatus,
+			Optional:       attendee.Optional,
+			Organizer:      attendee.Organizer,
+			Self:           attendee.Self,
+			Resource:       attendee.Resource,
+		})
+	}
+
+	event := &Event{
+		ID:               googleEvent.Id,
+		Summary:          googleEvent.Summary,
+		Description:      googleEvent.Description,
+		StartTime:        startTime,
+		EndTime:          endTime,
+		AllDay:           allDay,
+		Location:         googleEvent.Location,
+		Attendees:        attendees,
+		AttendeeDetails:  attendeeDetails,
+		Reminders:        convertGoogleReminders(googleEvent.Reminders),
+		Status:           googleEvent.Status,
+		Visibility:       googleEvent.Visibility,
+		Transparency:     googleEvent.Transparency,
+		ColorID:          googleEvent.ColorId,
+		EventType:        googleEvent.EventType,
+		Recurrence:       googleEvent.Recurrence,
+		RecurringEventID: googleEvent.RecurringEventId,
+		ICalUID:          googleEvent.ICalUID,
+		HTMLLink:         googleEvent.HtmlLink,
+		ETag:             googleEvent.Etag,
+		CreatedAt:        createdTime,
+		UpdatedAt:        updatedTime,
+	}
+
+	if googleEvent.Start != nil {
+		event.TimeZone = googleEvent.Start.TimeZone
+	}
+
+	if original := googleEvent.OriginalStartTime; original != nil {
+		if originalStart, _ := s.parseEventDateTime(original); !originalStart.IsZero() {
+			event.OriginalStartTime = &originalStart
+		}
 	}
 
-	return &Event{
-		ID:          googleEvent.Id,
-		Summary:     googleEvent.Summary,
-		Description: googleEvent.Description,
-		StartTime:   startTime,
-		EndTime:     endTime,
-		Location:    googleEvent.Location,
-		Attendees:   attendees,
-		Status:      googleEvent.Status,
-		CreatedAt:   createdTime,
-		UpdatedAt:   updatedTime,
+	if googleEvent.Organizer != nil {
+		event.Organizer = googleEvent.Organizer.Email
+	}
+
+	if conference := googleEvent.ConferenceData; conference != nil {
+		event.Conference = &ConferenceInfo{ConferenceID: conference.ConferenceId}
+		if conference.ConferenceSolution != nil {
+			event.Conference.Solution = conference.ConferenceSolution.Name
+		}
+		for _, entryPoint := range conference.EntryPoints {
+			event.Conference.EntryPoints = append(event.Conference.EntryPoints, ConferenceEntryPoint{
+				Type:  entryPoint.EntryPointType,
+				URI:   entryPoint.Uri,
+				Label: entryPoint.Label,
+			})
+		}
+	} else if googleEvent.HangoutLink != "" {
+		event.Conference = &ConferenceInfo{
+			EntryPoints: []ConferenceEntryPoint{{Type: "video", URI: googleEvent.HangoutLink}},
+		}
+	}
+
+	if props := googleEvent.ExtendedProperties; props != nil && (len(props.Private) > 0 || len(props.Shared) > 0) {
+		event.ExtendedProperties = &ExtendedProperties{
+			Private: props.Private,
+			Shared:  props.Shared,
+		}
+	}
+
+	for _, attachment := range googleEvent.Attachments {
+		event.Attachments = append(event.Attachments, EventAttachment{
+			FileURL:  attachment.FileUrl,
+			Title:    attachment.Title,
+			MimeType: attachment.MimeType,
+			FileID:   attachment.FileId,
+			IconLink: attachment.IconLink,
+		})
 	}
+
+	if props := googleEvent.FocusTimeProperties; props != nil {
+		event.FocusTime = &FocusTimeProperties{
+			AutoDeclineMode: props.AutoDeclineMode,
+			DeclineMessage:  props.DeclineMessage,
+			ChatStatus:      props.ChatStatus,
+		}
+	}
+
+	if props := googleEvent.OutOfOfficeProperties; props != nil {
+		event.OutOfOffice = &OutOfOfficeProperties{
+			AutoDeclineMode: props.AutoDeclineMode,
+			DeclineMessage:  props.DeclineMessage,
+		}
+	}
+
+	if props := googleEvent.WorkingLocationProperties; props != nil {
+		event.WorkingLocation = &WorkingLocationProperties{Type: props.Type}
+		if props.OfficeLocation != nil {
+			event.WorkingLocation.Label = props.OfficeLocation.Label
+			event.WorkingLocation.BuildingID = props.OfficeLocation.BuildingId
+			event.WorkingLocation.FloorID = props.OfficeLocation.FloorId
+			event.WorkingLocation.DeskID = props.OfficeLocation.DeskId
+		}
+		if props.CustomLocation != nil {
+			event.WorkingLocation.Label = props.CustomLocation.Label
+		}
+	}
+
+	return event
 }
 
 // validateEventCreateRequest validates an event creation request
@@ -384,9 +915,310 @@ func (s *googleCalendarService) validateEventCreateRequest(req *EventCreateReque
 		}
 	}
 
+	// Cancelled events cannot be created, only confirmed or tentative ones
+	if req.Status == EventStatusCancelled {
+		return NewInvalidInputError(ErrCodeInvalidEventData, "Cannot create a cancelled event", "Status must be 'confirmed' or 'tentative'")
+	}
+
+	if err := validateEventProperties(req.Visibility, req.Transparency, req.ColorID, req.Status); err != nil {
+		return err
+	}
+
+	if err := ValidateExtendedProperties(req.ExtendedProperties); err != nil {
+		return err
+	}
+
+	if err := validateAttachments(req.Attachments); err != nil {
+		return err
+	}
+
+	return ValidateReminders(req.Reminders)
+}
+
+// validateEventProperties validates visibility, transparency, color and status values.
+// Empty values are treated as unset and skipped.
+func validateEventProperties(visibility, transparency, colorID, status string) error {
+	switch visibility {
+	case "", EventVisibilityDefault, EventVisibilityPublic, EventVisibilityPrivate, EventVisibilityConfidential:
+	default:
+		return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid visibility: %s", visibility),
+			"Visibility must be one of: default, public, private, confidential")
+	}
+
+	switch transparency {
+	case "", EventTransparencyOpaque, EventTransparencyTransparent:
+	default:
+		return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid transparency: %s", transparency),
+			"Transparency must be 'opaque' (busy) or 'transparent' (free)")
+	}
+
+	switch status {
+	case "", EventStatusConfirmed, EventStatusTentative, EventStatusCancelled:
+	default:
+		return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid status: %s", status),
+			"Status must be one of: confirmed, tentative, cancelled")
+	}
+
+	if colorID != "" {
+		if id, err := strconv.Atoi(colorID); err != nil || id < 1 {
+			return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid color ID: %s", colorID),
+				"Color ID must be one of the IDs returned by list_event_colors")
+		}
+	}
+
+	return nil
+}
+
+// validateEventTypes validates event type filters
+func validateEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		switch eventType {
+		case EventTypeDefault, EventTypeFocusTime, EventTypeOutOfOffice, EventTypeWorkingLocation,
+			EventTypeFromGmail, EventTypeBirthday:
+		default:
+			return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid event type: %s", eventType),
+				"Event type must be one of: default, focusTime, outOfOffice, workingLocation, fromGmail, birthday")
+		}
+	}
 	return nil
 }
 
+// FilterListedEvents returns up to limit events from a page that pass the
+// list filters Google does not support server-side
+func FilterListedEvents(page []*calendar.Event, req *ListEventsRequest, limit int) []*calendar.Event {
+	var matching []*calendar.Event
+	for _, googleEvent := range page {
+		if len(matching) >= limit {
+			break
+		}
+		if MatchesListFilters(googleEvent, req) {
+			matching = append(matching, googleEvent)
+		}
+	}
+	return matching
+}
+
+// MatchesListFilters applies the list filters that Google does not support server-side
+func MatchesListFilters(googleEvent *calendar.Event, req *ListEventsRequest) bool {
+	if req.HideDeclined && selfResponseStatus(googleEvent) == ResponseStatusDeclined {
+		return false
+	}
+
+	if req.OrganizedByMe && (googleEvent.Organizer == nil || !googleEvent.Organizer.Self) {
+		return false
+	}
+
+	if req.WithAttendeesOnly && len(googleEvent.Attendees) == 0 {
+		return false
+	}
+
+	return true
+}
+
+// selfResponseStatus returns the calendar owner's response to an event, or an
+// empty string if the owner is not listed as an attendee
+func selfResponseStatus(googleEvent *calendar.Event) string {
+	for _, attendee := range googleEvent.Attendees {
+		if attendee.Self {
+			return attendee.ResponseStatus
+		}
+	}
+	return ""
+}
+
+// ValidateExtendedProperties validates extended property keys
+func ValidateExtendedProperties(props *ExtendedProperties) error {
+	if props == nil {
+		return nil
+	}
+	for _, values := range []map[string]string{props.Private, props.Shared} {
+		for key := range values {
+			if key == "" || strings.ContainsAny(key, "=,") {
+				return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid extended property key: %q", key),
+					"Keys must be non-empty and must not contain '=' or ','")
+			}
+		}
+	}
+	return nil
+}
+
+// validateAttachments validates event attachments
+func validateAttachments(attachments []EventAttachment) error {
+	if len(attachments) > MaxAttachments {
+		return NewInvalidInputError(ErrCodeInvalidEventData,
+			fmt.Sprintf("Too many attachments: %d", len(attachments)),
+			fmt.Sprintf("At most %d attachments are allowed", MaxAttachments))
+	}
+
+	for _, attachment := range attachments {
+		if !strings.HasPrefix(attachment.FileURL, "https://") && !strings.HasPrefix(attachment.FileURL, "http://") {
+			return NewInvalidInputError(ErrCodeInvalidEventData,
+				fmt.Sprintf("Invalid attachment URL: %q", attachment.FileURL),
+				"Attachment file_url must be an http(s) URL")
+		}
+	}
+
+	return nil
+}
+
+// convertAttachmentsToGoogle converts attachments to Google Calendar attachments
+func convertAttachmentsToGoogle(attachments []EventAttachment) []*calendar.EventAttachment {
+	googleAttachments := make([]*calendar.EventAttachment, len(attachments))
+	for i, attachment := range attachments {
+		googleAttachments[i] = &calendar.EventAttachment{
+			FileUrl:  attachment.FileURL,
+			Title:    attachment.Title,
+			MimeType: attachment.MimeType,
+		}
+	}
+	return googleAttachments
+}
+
+// validatePropertyFilter validates extended property filters
+func validatePropertyFilter(filter PropertyFilter) error {
+	return ValidateExtendedProperties(&ExtendedProperties{
+		Private: filter.PrivateProperties,
+		Shared:  filter.SharedProperties,
+	})
+}
+
+// applyPropertyFilter adds extended property constraints to an events list call
+func applyPropertyFilter(call *calendar.EventsListCall, filter PropertyFilter) *calendar.EventsListCall {
+	if len(filter.PrivateProperties) > 0 {
+		call = call.PrivateExtendedProperty(formatPropertyConstraints(filter.PrivateProperties)...)
+	}
+	if len(filter.SharedProperties) > 0 {
+		call = call.SharedExtendedProperty(formatPropertyConstraints(filter.SharedProperties)...)
+	}
+	return call
+}
+
+// formatPropertyConstraints formats properties as sorted key=value constraints
+func formatPropertyConstraints(properties map[string]string) []string {
+	constraints := make([]string, 0, len(properties))
+	for key, value := range properties {
+		constraints = append(constraints, key+"="+value)
+	}
+	sort.Strings(constraints)
+	return constraints
+}
+
+// MergeProperties merges updates into existing properties. Empty values remove keys.
+func MergeProperties(existing, updates map[string]string) map[string]string {
+	if existing == nil {
+		existing = make(map[string]string)
+	}
+	for key, value := range updates {
+		if value == "" {
+			delete(existing, key)
+		} else {
+			existing[key] = value
+		}
+	}
+	return existing
+}
+
+// maxResultsOrDefault returns maxResults, or DefaultMaxResults if it is not set
+func maxResultsOrDefault(maxResults int) int {
+	if maxResults <= 0 {
+		return DefaultMaxResults
+	}
+	return maxResults
+}
+
+// validateSpecialEventTimes validates the time range of a special event type
+func validateSpecialEventTimes(startTime, endTime time.Time) error {
+	if startTime.IsZero() || endTime.IsZero() {
+		return NewInvalidInputError(ErrCodeInvalidTimeFormat, "Start and end time are required", "")
+	}
+	if !startTime.Before(endTime) {
+		return NewInvalidInputError(ErrCodeInvalidTimeRange, "Start time must be before end time", "")
+	}
+	return nil
+}
+
+// validateAutoDeclineMode validates an auto-decline mode. An empty mode is allowed.
+func validateAutoDeclineMode(mode string) error {
+	switch mode {
+	case "", AutoDeclineNone, AutoDeclineAllConflicting, AutoDeclineOnlyNew:
+		return nil
+	}
+	return NewInvalidInputError(ErrCodeInvalidEventData, fmt.Sprintf("Invalid auto-decline mode: %s", mode),
+		"Auto-decline mode must be one of: declineNone, declineAllConflictingInvitations, declineOnlyNewConflictingInvitations")
+}
+
+// derefString returns the value of a string pointer, or an empty string if nil
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// ValidateReminders validates reminder overrides against Google Calendar limits
+func ValidateReminders(reminders []EventReminder) error {
+	if len(reminders) > MaxReminderOverrides {
+		return NewInvalidInputError(ErrCodeInvalidEventData,
+			fmt.Sprintf("Too many reminders: %d", len(reminders)),
+			fmt.Sprintf("At most %d reminder overrides are allowed", MaxReminderOverrides))
+	}
+
+	for _, reminder := range reminders {
+		if reminder.Method != ReminderMethodPopup && reminder.Method != ReminderMethodEmail {
+			return NewInvalidInputError(ErrCodeInvalidEventData,
+				fmt.Sprintf("Invalid reminder method: %s", reminder.Method),
+				"Reminder method must be 'popup' or 'email'")
+		}
+		if reminder.Minutes < 0 || reminder.Minutes > MaxReminderMinutes {
+			return NewInvalidInputError(ErrCodeInvalidEventData,
+				fmt.Sprintf("Invalid reminder minutes: %d", reminder.Minutes),
+				fmt.Sprintf("Reminder minutes must be between 0 and %d", MaxReminderMinutes))
+		}
+	}
+
+	return nil
+}
+
+// convertRemindersToGoogle converts reminder overrides to Google Calendar reminders.
+// An empty list restores the calendar's default reminders.
+func convertRemindersToGoogle(reminders []EventReminder) *calendar.EventReminders {
+	if len(reminders) == 0 {
+		return &calendar.EventReminders{UseDefault: true}
+	}
+
+	overrides := make([]*calendar.EventReminder, len(reminders))
+	for i, reminder := range reminders {
+		overrides[i] = &calendar.EventReminder{
+			Method:          reminder.Method,
+			Minutes:         int64(reminder.Minutes),
+			ForceSendFields: []string{"Minutes"},
+		}
+	}
+
+	return &calendar.EventReminders{
+		UseDefault:      false,
+		Overrides:       overrides,
+		ForceSendFields: []string{"UseDefault"},
+	}
+}
+
+// convertGoogleReminders converts Google Calendar reminders to our Reminders struct
+func convertGoogleReminders(googleReminders *calendar.EventReminders) *Reminders {
+	if googleReminders == nil {
+		return nil
+	}
+
+	reminders := &Reminders{UseDefault: googleReminders.UseDefault}
+	for _, override := range googleReminders.Overrides {
+		reminders.Overrides = append(reminders.Overrides, EventReminder{
+			Method:  override.Method,
+			Minutes: int(override.Minutes),
+		})
+	}
+
+	return reminders
+}
+
 // applyEventUpdates applies updates to an existing Google Calendar event
 func (s *googleCalendarService) applyEventUpdates(event *calendar.Event, update *EventUpdateRequest) {
 	if update.Summary != nil {
@@ -423,4 +1255,36 @@ func (s *googleCalendarService) applyEventUpdates(event *calendar.Event, update
 			}
 		}
 	}
+
+	if update.Reminders != nil {
+		event.Reminders = convertRemindersToGoogle(update.Reminders)
+	}
+
+	if update.Visibility != nil {
+		event.Visibility = *update.Visibility
+	}
+
+	if update.Transparency != nil {
+		event.Transparency = *update.Transparency
+	}
+
+	if update.ColorID != nil {
+		event.ColorId = *update.ColorID
+	}
+
+	if update.Status != nil {
+		event.Status = *update.Status
+	}
+
+	if update.Attachments != nil {
+		event.Attachments = convertAttachmentsToGoogle(update.Attachments)
+	}
+
+	if props := update.ExtendedProperties; props != nil {
+		if event.ExtendedProperties == nil {
+			event.ExtendedProperties = &calendar.EventExtendedProperties{}
+		}
+		event.ExtendedProperties.Private = MergeProperties(event.ExtendedProperties.Private, props.Private)
+		event.ExtendedProperties.Shared = MergeProperties(event.ExtendedProperties.Shared, props.Shared)
+	}
 }
@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// weekStartNames maps the Settings API weekStart values to day names
+var weekStartNames = map[string]string{
+	"0": "sunday",
+	"1": "monday",
+	"6": "saturday",
+}
+
+// GetSettings retrieves the authenticated user's calendar settings
+func (s *googleCalendarService) GetSettings(ctx context.Context) (*CalendarSettings, error) {
+	service, err := s.authManager.GetCalendarService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchSettings(ctx, service)
+}
+
+// fetchSettings retrieves all settings using an authenticated Google Calendar service
+func fetchSettings(ctx context.Context, service *calendar.Service) (*CalendarSettings, error) {
+	all := make(map[string]string)
+	err := service.Settings.List().Pages(ctx, func(page *calendar.Settings) error {
+		for _, setting := range page.Items {
+			all[setting.Id] = setting.Value
+		}
+		return nil
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			return nil, NewPermissionError(ErrCodePermissionDenied, "Permission denied to read calendar settings")
+		}
+		return nil, NewInternalError(ErrCodeServiceUnavailable, "Failed to retrieve calendar settings", err)
+	}
+
+	settings := &CalendarSettings{
+		TimeZone:     all["timezone"],
+		WeekStart:    all["weekStart"],
+		Locale:       all["locale"],
+		HideWeekends: all["hideWeekends"] == "true",
+		All:          all,
+	}
+
+	if name, ok := weekStartNames[settings.WeekStart]; ok {
+		settings.WeekStart = name
+	}
+
+	settings.Format24HourTime, _ = strconv.ParseBool(all["format24HourTime"])
+	settings.DefaultEventLength, _ = strconv.Atoi(all["defaultEventLength"])
+
+	return settings, nil
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,17 +32,43 @@ func NewToolManager(service CalendarService, config *CalendarConfig) *ToolManage
 func (tm *ToolManager) RegisterTools(s *server.MCPServer) {
 	tm.registerCheckAvailabilityTool(s)
 	tm.registerCreateEventTool(s)
+	tm.registerQuickAddEventTool(s)
 	tm.registerListEventsTool(s)
+	tm.registerGetEventTool(s)
 	tm.registerUpdateEventTool(s)
 	tm.registerDeleteEventTool(s)
+	tm.registerMoveEventTool(s)
 	tm.registerSearchEventsTool(s)
 	tm.registerGetCalendarInfoTool(s)
+	tm.registerGetCalendarSettingsTool(s)
+	tm.registerListEventColorsTool(s)
+	tm.registerCreateFocusTimeTool(s)
+	tm.registerCreateOutOfOfficeTool(s)
+	tm.registerSetWorkingLocationTool(s)
+	tm.registerListCalendarsTool(s)
+	tm.registerCreateCalendarTool(s)
+	tm.registerUpdateCalendarTool(s)
+	tm.registerDeleteCalendarTool(s)
+	tm.registerSubscribeCalendarTool(s)
+	tm.registerUnsubscribeCalendarTool(s)
+	tm.registerListCalendarACLTool(s)
+	tm.registerShareCalendarTool(s)
+	tm.registerUnshareCalendarTool(s)
+	tm.registerImportICSTool(s)
+	tm.registerExportICSTool(s)
+	tm.registerFindFreeBusyTool(s)
+	tm.registerSuggestMeetingTimesTool(s)
+	tm.registerFindConflictsTool(s)
+	tm.registerListRoomsTool(s)
+	tm.registerListBookableSlotsTool(s)
+	tm.registerBookSlotTool(s)
+	tm.registerRescheduleEventTool(s)
 }
 
 // registerCheckAvailabilityTool registers the check availability tool
 func (tm *ToolManager) registerCheckAvailabilityTool(s *server.MCPServer) {
 	tool := mcp.NewTool("check_google_calendar",
-		mcp.WithDescription("Checks for available time slots in a Google Calendar within a specified time range."),
+		mcp.WithDescription("Checks for available time slots in a Google Calendar within a specified time range. Cancelled, declined and free (transparent) events do not block time unless requested."),
 		mcp.WithString("start_time",
 			mcp.Required(),
 			mcp.Description("The start of the time window to check, in RFC3339 format (e.g., 2024-07-22T09:00:00Z)."),
@@ -52,6 +80,28 @@ func (tm *ToolManager) registerCheckAvailabilityTool(s *server.MCPServer) {
 		mcp.WithString("calendar_id",
 			mcp.Description("Specific calendar ID to check. Defaults to primary calendar if not provided."),
 		),
+		mcp.WithBoolean("working_hours_only",
+			mcp.Description("Only report free time within the calendar owner's working hours; free time outside them is marked out_of_hours (default: false)."),
+		),
+		mcp.WithNumber("buffer_minutes",
+			mcp.Description("Minutes of padding to keep free around every busy event, e.g. for travel (default: 0)."),
+		),
+		mcp.WithNumber("min_duration_minutes",
+			mcp.Description("Omit free slots shorter than this many minutes (default: 0)."),
+		),
+		mcp.WithNumber("slot_minutes",
+			mcp.Description("Split free time into bookable slots of this many minutes, aligned to the hour (e.g., 30 gives slots at :00 and :30)."),
+		),
+		mcp.WithString("tentative",
+			mcp.Description("Whether tentative events block time (default: busy)."),
+			mcp.Enum("busy", "free"),
+		),
+		mcp.WithBoolean("count_transparent",
+			mcp.Description("Treat events marked as free (transparent) as busy (default: false)."),
+		),
+		mcp.WithBoolean("count_declined",
+			mcp.Description("Treat events you declined as busy (default: false)."),
+		),
 	)
 
 	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -82,7 +132,20 @@ func (tm *ToolManager) registerCheckAvailabilityTool(s *server.MCPServer) {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid end_time format. Please use RFC3339 format: %v", err)), nil
 		}
 
-		timeSlots, err := tm.service.CheckAvailability(ctx, startTime, endTime)
+		timeSlots, err := tm.service.CheckAvailability(ctx, &AvailabilityRequest{
+			StartTime:        startTime,
+			EndTime:          endTime,
+			CalendarID:       request.GetString("calendar_id", ""),
+			WorkingHoursOnly: request.GetBool("working_hours_only", false),
+			Buffer:           time.Duration(request.GetFloat("buffer_minutes", 0)) * time.Minute,
+			MinSlotDuration:  time.Duration(request.GetFloat("min_duration_minutes", 0)) * time.Minute,
+			SlotSize:         time.Duration(request.GetFloat("slot_minutes", 0)) * time.Minute,
+			BusyRules: BusyRules{
+				CountTransparent: request.GetBool("count_transparent", false),
+				CountDeclined:    request.GetBool("count_declined", false),
+				TentativeAsFree:  request.GetString("tentative", "busy") == "free",
+			},
+		})
 		if err != nil {
 			if calErr, ok := err.(CalendarError); ok {
 				return mcp.NewToolResultError(calErr.Error()), nil
@@ -105,7 +168,7 @@ func (tm *ToolManager) registerCheckAvailabilityTool(s *server.MCPServer) {
 // registerCreateEventTool registers the create event tool
 func (tm *ToolManager) registerCreateEventTool(s *server.MCPServer) {
 	tool := mcp.NewTool("create_calendar_event",
-		mcp.WithDescription("Creates a new event in a Google Calendar."),
+		mcp.WithDescription("Creates a new event in a Google Calendar. Refuses to double-book you or the attendees unless allow_conflicts is set."),
 		mcp.WithString("title",
 			mcp.Required(),
 			mcp.Description("The title/summary of the event."),
@@ -127,6 +190,39 @@ func (tm *ToolManager) registerCreateEventTool(s *server.MCPServer) {
 		mcp.WithString("attendees",
 			mcp.Description("Comma-separated list of attendee email addresses."),
 		),
+		mcp.WithString("reminders",
+			mcp.Description("Comma-separated reminder overrides as method:minutes (e.g., popup:10,email:1440). Methods are popup or email; at most 5 reminders, 0-40320 minutes."),
+		),
+		mcp.WithString("visibility",
+			mcp.Description("Visibility of the event (default: calendar default)."),
+			mcp.Enum("default", "public", "private", "confidential"),
+		),
+		mcp.WithString("transparency",
+			mcp.Description("Whether the event blocks time: 'opaque' shows as busy, 'transparent' shows as free."),
+			mcp.Enum("opaque", "transparent"),
+		),
+		mcp.WithString("color_id",
+			mcp.Description("Event color ID. Use list_event_colors to see available colors."),
+		),
+		mcp.WithString("status",
+			mcp.Description("Status of the event (default: confirmed)."),
+			mcp.Enum("confirmed", "tentative"),
+		),
+		mcp.WithString("private_properties",
+			mcp.Description("Comma-separated key=value pairs stored as private extended properties (e.g., source=agent,ticket=OPS-42)."),
+		),
+		mcp.WithString("shared_properties",
+			mcp.Description("Comma-separated key=value pairs stored as shared extended properties, visible to all attendees."),
+		),
+		mcp.WithString("attachments",
+			mcp.Description(`JSON array of attachments, e.g. [{"file_url": "https://docs.google.com/document/d/...", "title": "Agenda", "mime_type": "application/vnd.google-apps.document"}]. At most 25 attachments.`),
+		),
+		mcp.WithString("rooms",
+			mcp.Description("Comma-separated room emails to book as resource attendees. Use list_rooms to find a free room."),
+		),
+		mcp.WithBoolean("allow_conflicts",
+			mcp.Description("Create the event even if it overlaps busy time on your or an attendee's calendar (default: false, which refuses with a conflict error listing the clashes)."),
+		),
 	)
 
 	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -185,6 +281,36 @@ func (tm *ToolManager) registerCreateEventTool(s *server.MCPServer) {
 			eventReq.Attendees = attendees
 		}
 
+		if remindersStr := request.GetString("reminders", ""); remindersStr != "" {
+			reminders, err := ParseReminders(remindersStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid reminders: %v", err)), nil
+			}
+			eventReq.Reminders = reminders
+		}
+
+		eventReq.Visibility = request.GetString("visibility", "")
+		eventReq.Transparency = request.GetString("transparency", "")
+		eventReq.ColorID = request.GetString("color_id", "")
+		eventReq.Status = request.GetString("status", "")
+
+		extendedProperties, err := parseExtendedProperties(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		eventReq.ExtendedProperties = extendedProperties
+
+		if attachmentsStr := request.GetString("attachments", ""); attachmentsStr != "" {
+			attachments, err := parseAttachments(attachmentsStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid attachments: %v", err)), nil
+			}
+			eventReq.Attachments = attachments
+		}
+
+		eventReq.Rooms = splitAndTrim(request.GetString("rooms", ""))
+		eventReq.AllowConflicts = request.GetBool("allow_conflicts", false)
+
 		event, err := tm.service.CreateEvent(ctx, eventReq)
 		if err != nil {
 			if calErr, ok := err.(CalendarError); ok {
@@ -203,6 +329,48 @@ func (tm *ToolManager) registerCreateEventTool(s *server.MCPServer) {
 	})
 }
 
+// registerQuickAddEventTool registers the quick add event tool
+func (tm *ToolManager) registerQuickAddEventTool(s *server.MCPServer) {
+	tool := mcp.NewTool("quick_add_event",
+		mcp.WithDescription("Creates an event from a short natural language description, e.g. 'Lunch with Sam tomorrow 12pm at Cafe X'. Returns the parsed event so it can be checked and corrected with update_calendar_event."),
+		mcp.WithString("text",
+			mcp.Required(),
+			mcp.Description("The text describing the event, including its title, time and optionally location."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'quick_add_event' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		text, err := request.RequireString("text")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid text: %v", err)), nil
+		}
+
+		event, err := tm.service.QuickAddEvent(ctx, text)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to quick-add event: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully created event '%s' from text", event.Summary),
+			"text":    text,
+			"event":   event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
 // registerListEventsTool registers the list events tool
 func (tm *ToolManager) registerListEventsTool(s *server.MCPServer) {
 	tool := mcp.NewTool("list_calendar_events",
@@ -218,6 +386,27 @@ func (tm *ToolManager) registerListEventsTool(s *server.MCPServer) {
 		mcp.WithNumber("max_results",
 			mcp.Description("Maximum number of events to return (default: 50)."),
 		),
+		mcp.WithString("private_properties",
+			mcp.Description("Only return events whose private extended properties match all of these comma-separated key=value pairs."),
+		),
+		mcp.WithString("shared_properties",
+			mcp.Description("Only return events whose shared extended properties match all of these comma-separated key=value pairs."),
+		),
+		mcp.WithBoolean("show_deleted",
+			mcp.Description("Include cancelled events (default: false)."),
+		),
+		mcp.WithBoolean("hide_declined",
+			mcp.Description("Exclude events the calendar owner has declined (default: false)."),
+		),
+		mcp.WithBoolean("organized_by_me",
+			mcp.Description("Only return events organized by the calendar owner (default: false)."),
+		),
+		mcp.WithBoolean("with_attendees_only",
+			mcp.Description("Only return events that have attendees (default: false)."),
+		),
+		mcp.WithString("event_types",
+			mcp.Description("Comma-separated event types to include: default, focusTime, outOfOffice, workingLocation, fromGmail, birthday."),
+		),
 	)
 
 	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -248,7 +437,27 @@ func (tm *ToolManager) registerListEventsTool(s *server.MCPServer) {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid end_time format. Please use RFC3339 format: %v", err)), nil
 		}
 
-		events, err := tm.service.ListEvents(ctx, startTime, endTime)
+		filter, err := parsePropertyFilter(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		listReq := &ListEventsRequest{
+			StartTime:         startTime,
+			EndTime:           endTime,
+			MaxResults:        parseMaxResults(request),
+			PropertyFilter:    filter,
+			ShowDeleted:       request.GetBool("show_deleted", false),
+			HideDeclined:      request.GetBool("hide_declined", false),
+			OrganizedByMe:     request.GetBool("organized_by_me", false),
+			WithAttendeesOnly: request.GetBool("with_attendees_only", false),
+		}
+
+		if eventTypesStr := request.GetString("event_types", ""); eventTypesStr != "" {
+			listReq.EventTypes = splitAndTrim(eventTypesStr)
+		}
+
+		events, err := tm.service.ListEvents(ctx, listReq)
 		if err != nil {
 			if calErr, ok := err.(CalendarError); ok {
 				return mcp.NewToolResultError(calErr.Error()), nil
@@ -269,6 +478,45 @@ func (tm *ToolManager) registerListEventsTool(s *server.MCPServer) {
 	})
 }
 
+// registerGetEventTool registers the get event tool
+func (tm *ToolManager) registerGetEventTool(s *server.MCPServer) {
+	tool := mcp.NewTool("get_calendar_event",
+		mcp.WithDescription("Gets the current state of a single event by ID, including recurrence, conferencing, attendee responses and ETag."),
+		mcp.WithString("event_id",
+			mcp.Required(),
+			mcp.Description("The ID of the event to retrieve."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'get_calendar_event' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		eventID, err := request.RequireString("event_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid event_id: %v", err)), nil
+		}
+
+		event, err := tm.service.GetEvent(ctx, eventID)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get event: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"event": event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
 // registerUpdateEventTool registers the update event tool
 func (tm *ToolManager) registerUpdateEventTool(s *server.MCPServer) {
 	tool := mcp.NewTool("update_calendar_event",
@@ -295,6 +543,36 @@ func (tm *ToolManager) registerUpdateEventTool(s *server.MCPServer) {
 		mcp.WithString("attendees",
 			mcp.Description("Comma-separated list of attendee email addresses."),
 		),
+		mcp.WithString("reminders",
+			mcp.Description("Comma-separated reminder overrides as method:minutes (e.g., popup:10,email:1440), or 'default' to restore the calendar's default reminders."),
+		),
+		mcp.WithString("visibility",
+			mcp.Description("New visibility of the event."),
+			mcp.Enum("default", "public", "private", "confidential"),
+		),
+		mcp.WithString("transparency",
+			mcp.Description("Whether the event blocks time: 'opaque' shows as busy, 'transparent' shows as free."),
+			mcp.Enum("opaque", "transparent"),
+		),
+		mcp.WithString("color_id",
+			mcp.Description("New event color ID. Use list_event_colors to see available colors."),
+		),
+		mcp.WithString("status",
+			mcp.Description("New status of the event."),
+			mcp.Enum("confirmed", "tentative", "cancelled"),
+		),
+		mcp.WithString("private_properties",
+			mcp.Description("Comma-separated key=value pairs merged into the private extended properties. Use key= to remove a key."),
+		),
+		mcp.WithString("shared_properties",
+			mcp.Description("Comma-separated key=value pairs merged into the shared extended properties. Use key= to remove a key."),
+		),
+		mcp.WithString("attachments",
+			mcp.Description(`JSON array of attachments replacing the existing ones, e.g. [{"file_url": "https://docs.google.com/document/d/...", "title": "Agenda"}]. Use [] to remove all attachments.`),
+		),
+		mcp.WithBoolean("allow_conflicts",
+			mcp.Description("Apply new times or attendees even if they overlap busy time on your or an attendee's calendar (default: false, which refuses with a conflict error listing the clashes)."),
+		),
 	)
 
 	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -348,6 +626,50 @@ func (tm *ToolManager) registerUpdateEventTool(s *server.MCPServer) {
 			update.Attendees = attendees
 		}
 
+		if remindersStr := request.GetString("reminders", ""); remindersStr != "" {
+			if strings.EqualFold(strings.TrimSpace(remindersStr), "default") {
+				update.Reminders = []EventReminder{}
+			} else {
+				reminders, err := ParseReminders(remindersStr)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Invalid reminders: %v", err)), nil
+				}
+				update.Reminders = reminders
+			}
+		}
+
+		if visibility := request.GetString("visibility", ""); visibility != "" {
+			update.Visibility = &visibility
+		}
+
+		if transparency := request.GetString("transparency", ""); transparency != "" {
+			update.Transparency = &transparency
+		}
+
+		if colorID := request.GetString("color_id", ""); colorID != "" {
+			update.ColorID = &colorID
+		}
+
+		if status := request.GetString("status", ""); status != "" {
+			update.Status = &status
+		}
+
+		extendedProperties, err := parseExtendedProperties(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		update.ExtendedProperties = extendedProperties
+
+		if attachmentsStr := request.GetString("attachments", ""); attachmentsStr != "" {
+			attachments, err := parseAttachments(attachmentsStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid attachments: %v", err)), nil
+			}
+			update.Attachments = attachments
+		}
+
+		update.AllowConflicts = request.GetBool("allow_conflicts", false)
+
 		event, err := tm.service.UpdateEvent(ctx, eventID, update)
 		if err != nil {
 			if calErr, ok := err.(CalendarError); ok {
@@ -406,6 +728,57 @@ func (tm *ToolManager) registerDeleteEventTool(s *server.MCPServer) {
 	})
 }
 
+// registerMoveEventTool registers the move event tool
+func (tm *ToolManager) registerMoveEventTool(s *server.MCPServer) {
+	tool := mcp.NewTool("move_calendar_event",
+		mcp.WithDescription("Moves an event from the configured calendar to another calendar, keeping its ID, attendee responses and history."),
+		mcp.WithString("event_id",
+			mcp.Required(),
+			mcp.Description("The ID of the event to move."),
+		),
+		mcp.WithString("destination_calendar_id",
+			mcp.Required(),
+			mcp.Description("The ID of the calendar to move the event to."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'move_calendar_event' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		eventID, err := request.RequireString("event_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid event_id: %v", err)), nil
+		}
+
+		destinationCalendarID, err := request.RequireString("destination_calendar_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid destination_calendar_id: %v", err)), nil
+		}
+
+		event, err := tm.service.MoveEvent(ctx, eventID, destinationCalendarID)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to move event: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success":                 true,
+			"message":                 fmt.Sprintf("Successfully moved event '%s' to calendar %s", event.Summary, destinationCalendarID),
+			"destination_calendar_id": destinationCalendarID,
+			"event":                   event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
 // registerSearchEventsTool registers the search events tool
 func (tm *ToolManager) registerSearchEventsTool(s *server.MCPServer) {
 	tool := mcp.NewTool("search_calendar_events",
@@ -423,6 +796,12 @@ func (tm *ToolManager) registerSearchEventsTool(s *server.MCPServer) {
 		mcp.WithNumber("max_results",
 			mcp.Description("Maximum number of events to return (default: 50)."),
 		),
+		mcp.WithString("private_properties",
+			mcp.Description("Only return events whose private extended properties match all of these comma-separated key=value pairs."),
+		),
+		mcp.WithString("shared_properties",
+			mcp.Description("Only return events whose shared extended properties match all of these comma-separated key=value pairs."),
+		),
 	)
 
 	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -456,7 +835,18 @@ func (tm *ToolManager) registerSearchEventsTool(s *server.MCPServer) {
 			endTime = parsedTime
 		}
 
-		events, err := tm.service.SearchEvents(ctx, query, startTime, endTime)
+		filter, err := parsePropertyFilter(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		events, err := tm.service.SearchEvents(ctx, &SearchRequest{
+			Query:          query,
+			StartTime:      &startTime,
+			EndTime:        &endTime,
+			MaxResults:     parseMaxResults(request),
+			PropertyFilter: filter,
+		})
 		if err != nil {
 			if calErr, ok := err.(CalendarError); ok {
 				return mcp.NewToolResultError(calErr.Error()), nil
@@ -513,23 +903,1512 @@ func (tm *ToolManager) registerGetCalendarInfoTool(s *server.MCPServer) {
 	})
 }
 
-// Helper functions
+// registerGetCalendarSettingsTool registers the get calendar settings tool
+func (tm *ToolManager) registerGetCalendarSettingsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("get_calendar_settings",
+		mcp.WithDescription("Gets the account's Google Calendar settings such as timezone, week start, locale and default event length."),
+	)
 
-// checkServiceAvailability checks if the calendar service is available
-func (tm *ToolManager) checkServiceAvailability() *mcp.CallToolResult {
-	if tm.service == nil {
-		return mcp.NewToolResultError("Calendar service unavailable. Please check your Google Calendar credentials configuration.")
-	}
-	return nil
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'get_calendar_settings' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		settings, err := tm.service.GetSettings(ctx)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get calendar settings: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"settings":           settings,
+			"effective_timezone": tm.config.TimeZone,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
 }
 
-// parseMaxResults parses the max_results parameter with a default value
-func parseMaxResults(request mcp.CallToolRequest) int {
-	maxResultsFloat := request.GetFloat("max_results", 0)
-	if maxResults := int(maxResultsFloat); maxResults > 0 && maxResults <= 250 {
-		return maxResults
-	}
-	return DefaultMaxResults
+// registerListEventColorsTool registers the list event colors tool
+func (tm *ToolManager) registerListEventColorsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("list_event_colors",
+		mcp.WithDescription("Lists the color palette available for Google Calendar events."),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'list_event_colors' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		colors, err := tm.service.ListEventColors(ctx)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list event colors: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"color_count": len(colors),
+			"colors":      colors,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerCreateFocusTimeTool registers the create focus time tool
+func (tm *ToolManager) registerCreateFocusTimeTool(s *server.MCPServer) {
+	tool := mcp.NewTool("create_focus_time",
+		mcp.WithDescription("Creates a focus time event that blocks the time and can automatically decline conflicting meetings."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("The start time for the focus time, in RFC3339 format."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("The end time for the focus time, in RFC3339 format."),
+		),
+		mcp.WithString("title",
+			mcp.Description("The title of the event (default: Focus time)."),
+		),
+		mcp.WithString("auto_decline_mode",
+			mcp.Description("Which conflicting invitations to decline automatically."),
+			mcp.Enum(AutoDeclineNone, AutoDeclineAllConflicting, AutoDeclineOnlyNew),
+		),
+		mcp.WithString("decline_message",
+			mcp.Description("Message sent when an invitation is automatically declined."),
+		),
+		mcp.WithString("chat_status",
+			mcp.Description("Chat status to set during the focus time."),
+			mcp.Enum(ChatStatusAvailable, ChatStatusDoNotDisturb),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'create_focus_time' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		event, err := tm.service.CreateFocusTime(ctx, &FocusTimeRequest{
+			Summary:         request.GetString("title", ""),
+			StartTime:       startTime,
+			EndTime:         endTime,
+			AutoDeclineMode: request.GetString("auto_decline_mode", ""),
+			DeclineMessage:  request.GetString("decline_message", ""),
+			ChatStatus:      request.GetString("chat_status", ""),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create focus time: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully created focus time '%s'", event.Summary),
+			"event":   event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerCreateOutOfOfficeTool registers the create out-of-office tool
+func (tm *ToolManager) registerCreateOutOfOfficeTool(s *server.MCPServer) {
+	tool := mcp.NewTool("create_out_of_office",
+		mcp.WithDescription("Creates an out-of-office event that can automatically decline meetings during the absence."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("The start of the absence, in RFC3339 format."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("The end of the absence, in RFC3339 format."),
+		),
+		mcp.WithString("title",
+			mcp.Description("The title of the event (default: Out of office)."),
+		),
+		mcp.WithString("auto_decline_mode",
+			mcp.Description("Which conflicting invitations to decline automatically."),
+			mcp.Enum(AutoDeclineNone, AutoDeclineAllConflicting, AutoDeclineOnlyNew),
+		),
+		mcp.WithString("decline_message",
+			mcp.Description("Message sent when an invitation is automatically declined."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'create_out_of_office' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		event, err := tm.service.CreateOutOfOffice(ctx, &OutOfOfficeRequest{
+			Summary:         request.GetString("title", ""),
+			StartTime:       startTime,
+			EndTime:         endTime,
+			AutoDeclineMode: request.GetString("auto_decline_mode", ""),
+			DeclineMessage:  request.GetString("decline_message", ""),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create out-of-office event: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully created out-of-office event '%s'", event.Summary),
+			"event":   event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerSetWorkingLocationTool registers the set working location tool
+func (tm *ToolManager) registerSetWorkingLocationTool(s *server.MCPServer) {
+	tool := mcp.NewTool("set_working_location",
+		mcp.WithDescription("Sets where the user is working for a time range. Working location events do not block availability."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("The start of the time range, in RFC3339 format."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("The end of the time range, in RFC3339 format."),
+		),
+		mcp.WithString("location_type",
+			mcp.Required(),
+			mcp.Description("The kind of working location."),
+			mcp.Enum(WorkingLocationHomeOffice, WorkingLocationOffice, WorkingLocationCustom),
+		),
+		mcp.WithString("label",
+			mcp.Description("Display name of the location. Required for customLocation."),
+		),
+		mcp.WithString("building_id",
+			mcp.Description("Building ID for an office location."),
+		),
+		mcp.WithString("floor_id",
+			mcp.Description("Floor ID for an office location."),
+		),
+		mcp.WithString("desk_id",
+			mcp.Description("Desk ID for an office location."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'set_working_location' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		locationType, err := request.RequireString("location_type")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid location_type: %v", err)), nil
+		}
+
+		event, err := tm.service.SetWorkingLocation(ctx, &WorkingLocationRequest{
+			StartTime: startTime,
+			EndTime:   endTime,
+			Location: WorkingLocationProperties{
+				Type:       locationType,
+				Label:      request.GetString("label", ""),
+				BuildingID: request.GetString("building_id", ""),
+				FloorID:    request.GetString("floor_id", ""),
+				DeskID:     request.GetString("desk_id", ""),
+			},
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set working location: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully set working location '%s'", event.Summary),
+			"event":   event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerListCalendarsTool registers the list calendars tool
+func (tm *ToolManager) registerListCalendarsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("list_calendars",
+		mcp.WithDescription("Lists the calendars the account can access, with access role, color and primary/hidden flags."),
+		mcp.WithBoolean("show_hidden",
+			mcp.Description("Include calendars hidden from the calendar list (default: false)."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'list_calendars' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		calendars, err := tm.service.ListCalendars(ctx, request.GetBool("show_hidden", false))
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list calendars: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"calendar_count": len(calendars),
+			"calendars":      calendars,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerCreateCalendarTool registers the create calendar tool
+func (tm *ToolManager) registerCreateCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("create_calendar",
+		mcp.WithDescription("Creates a new secondary calendar owned by the account."),
+		mcp.WithString("summary",
+			mcp.Required(),
+			mcp.Description("The name of the calendar."),
+		),
+		mcp.WithString("description",
+			mcp.Description("A description for the calendar."),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone of the calendar (default: the configured timezone)."),
+		),
+		mcp.WithString("location",
+			mcp.Description("Geographic location of the calendar."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'create_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		summary, err := request.RequireString("summary")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid summary: %v", err)), nil
+		}
+
+		calendarInfo, err := tm.service.CreateCalendar(ctx, &CalendarCreateRequest{
+			Summary:     summary,
+			Description: request.GetString("description", ""),
+			TimeZone:    request.GetString("timezone", ""),
+			Location:    request.GetString("location", ""),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success":  true,
+			"message":  fmt.Sprintf("Successfully created calendar '%s'", calendarInfo.Summary),
+			"calendar": calendarInfo,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerUpdateCalendarTool registers the update calendar tool
+func (tm *ToolManager) registerUpdateCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("update_calendar",
+		mcp.WithDescription("Updates the name, description, timezone or location of a calendar."),
+		mcp.WithString("calendar_id",
+			mcp.Required(),
+			mcp.Description("The ID of the calendar to update."),
+		),
+		mcp.WithString("summary",
+			mcp.Description("New name for the calendar."),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description for the calendar. An empty string clears it."),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("New IANA timezone for the calendar."),
+		),
+		mcp.WithString("location",
+			mcp.Description("New location for the calendar. An empty string clears it."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'update_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		calendarID, err := request.RequireString("calendar_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid calendar_id: %v", err)), nil
+		}
+
+		update := &CalendarUpdateRequest{}
+
+		if summary := request.GetString("summary", ""); summary != "" {
+			update.Summary = &summary
+		}
+
+		// Description and location can be cleared, so an empty value still counts as set
+		args := request.GetArguments()
+		if description, ok := args["description"].(string); ok {
+			update.Description = &description
+		}
+
+		if timeZone := request.GetString("timezone", ""); timeZone != "" {
+			update.TimeZone = &timeZone
+		}
+
+		if location, ok := args["location"].(string); ok {
+			update.Location = &location
+		}
+
+		calendarInfo, err := tm.service.UpdateCalendar(ctx, calendarID, update)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success":  true,
+			"message":  fmt.Sprintf("Successfully updated calendar '%s'", calendarInfo.Summary),
+			"calendar": calendarInfo,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerDeleteCalendarTool registers the delete calendar tool
+func (tm *ToolManager) registerDeleteCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("delete_calendar",
+		mcp.WithDescription("Permanently deletes a secondary calendar and all of its events. The primary calendar cannot be deleted."),
+		mcp.WithString("calendar_id",
+			mcp.Required(),
+			mcp.Description("The ID of the calendar to delete."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'delete_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		calendarID, err := request.RequireString("calendar_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid calendar_id: %v", err)), nil
+		}
+
+		err = tm.service.DeleteCalendar(ctx, calendarID)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully deleted calendar with ID: %s", calendarID),
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerSubscribeCalendarTool registers the subscribe calendar tool
+func (tm *ToolManager) registerSubscribeCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("subscribe_calendar",
+		mcp.WithDescription("Adds an existing calendar (e.g., a shared team calendar) to the account's calendar list."),
+		mcp.WithString("calendar_id",
+			mcp.Required(),
+			mcp.Description("The ID of the calendar to subscribe to."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'subscribe_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		calendarID, err := request.RequireString("calendar_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid calendar_id: %v", err)), nil
+		}
+
+		entry, err := tm.service.SubscribeCalendar(ctx, calendarID)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to subscribe to calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success":  true,
+			"message":  fmt.Sprintf("Successfully subscribed to calendar '%s'", entry.Summary),
+			"calendar": entry,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerUnsubscribeCalendarTool registers the unsubscribe calendar tool
+func (tm *ToolManager) registerUnsubscribeCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("unsubscribe_calendar",
+		mcp.WithDescription("Removes a calendar from the account's calendar list without deleting the calendar."),
+		mcp.WithString("calendar_id",
+			mcp.Required(),
+			mcp.Description("The ID of the calendar to unsubscribe from."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'unsubscribe_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		calendarID, err := request.RequireString("calendar_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid calendar_id: %v", err)), nil
+		}
+
+		err = tm.service.UnsubscribeCalendar(ctx, calendarID)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to unsubscribe from calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully unsubscribed from calendar with ID: %s", calendarID),
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerListCalendarACLTool registers the list calendar ACL tool
+func (tm *ToolManager) registerListCalendarACLTool(s *server.MCPServer) {
+	tool := mcp.NewTool("list_calendar_acl",
+		mcp.WithDescription("Lists who a calendar is shared with and their access roles."),
+		mcp.WithString("calendar_id",
+			mcp.Description("The ID of the calendar. Defaults to the configured calendar."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'list_calendar_acl' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		rules, err := tm.service.ListACL(ctx, request.GetString("calendar_id", ""))
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list sharing rules: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"rule_count": len(rules),
+			"rules":      rules,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerShareCalendarTool registers the share calendar tool
+func (tm *ToolManager) registerShareCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("share_calendar",
+		mcp.WithDescription("Shares a calendar with a user, group, domain or the public. Sharing again with the same scope changes its role."),
+		mcp.WithString("calendar_id",
+			mcp.Description("The ID of the calendar to share. Defaults to the configured calendar."),
+		),
+		mcp.WithString("role",
+			mcp.Required(),
+			mcp.Description("The access to grant."),
+			mcp.Enum(AccessRoleFreeBusyReader, AccessRoleReader, AccessRoleWriter, AccessRoleOwner),
+		),
+		mcp.WithString("scope_type",
+			mcp.Required(),
+			mcp.Description("Who to share with: a user or group email, a whole domain, or everyone (default)."),
+			mcp.Enum(ACLScopeUser, ACLScopeGroup, ACLScopeDomain, ACLScopeDefault),
+		),
+		mcp.WithString("scope_value",
+			mcp.Description("Email address for user/group scopes, or domain name for the domain scope. Omit for the default scope."),
+		),
+		mcp.WithBoolean("send_notifications",
+			mcp.Description("Whether to email the grantee about the share (default: true)."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'share_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		role, err := request.RequireString("role")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid role: %v", err)), nil
+		}
+
+		scopeType, err := request.RequireString("scope_type")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid scope_type: %v", err)), nil
+		}
+
+		rule, err := tm.service.ShareCalendar(ctx, &ShareCalendarRequest{
+			CalendarID:        request.GetString("calendar_id", ""),
+			Role:              role,
+			ScopeType:         scopeType,
+			ScopeValue:        strings.TrimSpace(request.GetString("scope_value", "")),
+			SendNotifications: request.GetBool("send_notifications", true),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to share calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully granted %s access to %s", rule.Role, rule.ID),
+			"rule":    rule,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerUnshareCalendarTool registers the unshare calendar tool
+func (tm *ToolManager) registerUnshareCalendarTool(s *server.MCPServer) {
+	tool := mcp.NewTool("unshare_calendar",
+		mcp.WithDescription("Removes a sharing rule from a calendar. Identify the rule by rule_id, or by scope_type and scope_value."),
+		mcp.WithString("calendar_id",
+			mcp.Description("The ID of the calendar. Defaults to the configured calendar."),
+		),
+		mcp.WithString("rule_id",
+			mcp.Description("The ID of the rule to remove, as returned by list_calendar_acl (e.g., user:jane@example.com)."),
+		),
+		mcp.WithString("scope_type",
+			mcp.Description("Scope type of the rule to remove, if rule_id is not given."),
+			mcp.Enum(ACLScopeUser, ACLScopeGroup, ACLScopeDomain, ACLScopeDefault),
+		),
+		mcp.WithString("scope_value",
+			mcp.Description("Email address or domain of the rule to remove, if rule_id is not given."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'unshare_calendar' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		ruleID := request.GetString("rule_id", "")
+		if ruleID == "" {
+			scopeType := request.GetString("scope_type", "")
+			if scopeType == "" {
+				return mcp.NewToolResultError("Either rule_id or scope_type must be provided"), nil
+			}
+			ruleID = aclRuleID(scopeType, strings.TrimSpace(request.GetString("scope_value", "")))
+		}
+
+		err := tm.service.UnshareCalendar(ctx, request.GetString("calendar_id", ""), ruleID)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to unshare calendar: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully removed sharing rule: %s", ruleID),
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerImportICSTool registers the iCalendar import tool
+func (tm *ToolManager) registerImportICSTool(s *server.MCPServer) {
+	tool := mcp.NewTool("import_ics",
+		mcp.WithDescription("Imports events from iCalendar (.ics) data, including recurrence rules, attendees and alarms. Re-importing the same events updates them instead of creating duplicates."),
+		mcp.WithString("ics_text",
+			mcp.Description("The iCalendar data (BEGIN:VCALENDAR ... END:VCALENDAR)."),
+		),
+		mcp.WithString("file_path",
+			mcp.Description("Path to an .ics file on the server, used if ics_text is not given."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'import_ics' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		data := request.GetString("ics_text", "")
+		if data == "" {
+			filePath := strings.TrimSpace(request.GetString("file_path", ""))
+			if filePath == "" {
+				return mcp.NewToolResultError("Either ics_text or file_path must be provided"), nil
+			}
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to read %s: %v", filePath, err)), nil
+			}
+			data = string(content)
+		}
+
+		result, err := tm.service.ImportICS(ctx, data)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to import events: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": result.Failed == 0,
+			"message": fmt.Sprintf("Imported %d of %d events", result.Imported, result.Total),
+			"result":  result,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerExportICSTool registers the iCalendar export tool
+func (tm *ToolManager) registerExportICSTool(s *server.MCPServer) {
+	tool := mcp.NewTool("export_ics",
+		mcp.WithDescription("Exports events in a time range as iCalendar (.ics) data that other calendar applications can import. Recurring events keep their recurrence rules."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("Start of the range in RFC3339 format (e.g., 2024-01-01T00:00:00Z)."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("End of the range in RFC3339 format (e.g., 2024-04-01T00:00:00Z)."),
+		),
+		mcp.WithString("file_path",
+			mcp.Description("Path on the server to write the .ics file to. If omitted, the iCalendar data is returned directly."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'export_ics' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		data, err := tm.service.ExportICS(ctx, startTime, endTime)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to export events: %v", err)), nil
+		}
+
+		filePath := strings.TrimSpace(request.GetString("file_path", ""))
+		if filePath == "" {
+			return mcp.NewToolResultText(data), nil
+		}
+
+		if err := os.WriteFile(filePath, []byte(data), 0644); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to write %s: %v", filePath, err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Exported events to %s", filePath),
+			"bytes":   len(data),
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerFindFreeBusyTool registers the multi-calendar free/busy tool
+func (tm *ToolManager) registerFindFreeBusyTool(s *server.MCPServer) {
+	tool := mcp.NewTool("find_free_busy",
+		mcp.WithDescription("Finds when several people, rooms or groups are busy and the times when all of them are free, using the Google Calendar free/busy API."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("The start of the time window, in RFC3339 format (e.g., 2024-07-22T09:00:00Z)."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("The end of the time window, in RFC3339 format (e.g., 2024-07-22T17:00:00Z)."),
+		),
+		mcp.WithString("calendars",
+			mcp.Description("Comma-separated calendar IDs, email addresses or group addresses (e.g., alice@example.com,bob@example.com,room-4b@resource.calendar.google.com). Defaults to the configured calendar."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'find_free_busy' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		freeBusy, err := tm.service.QueryFreeBusy(ctx, &FreeBusyRequest{
+			StartTime:   startTime,
+			EndTime:     endTime,
+			CalendarIDs: splitAndTrim(request.GetString("calendars", "")),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to query free/busy information: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(freeBusy, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerSuggestMeetingTimesTool registers the meeting time finder tool
+func (tm *ToolManager) registerSuggestMeetingTimesTool(s *server.MCPServer) {
+	tool := mcp.NewTool("suggest_meeting_times",
+		mcp.WithDescription("Suggests ranked meeting times when you and all attendees are free, honouring working hours, preferred days and times, buffers and a daily meeting limit. Each suggestion has a score and the reasons for it."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("The start of the search window, in RFC3339 format (e.g., 2024-07-22T00:00:00Z)."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("The end of the search window, in RFC3339 format (e.g., 2024-07-27T00:00:00Z)."),
+		),
+		mcp.WithNumber("duration_minutes",
+			mcp.Required(),
+			mcp.Description("The length of the meeting in minutes."),
+		),
+		mcp.WithString("attendees",
+			mcp.Description("Comma-separated attendee emails, calendar IDs or group addresses."),
+		),
+		mcp.WithString("optional_attendees",
+			mcp.Description("Comma-separated optional attendees. Times when they are busy are still suggested, with a lower score."),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone for working hours and preferences (e.g., Europe/London). Defaults to the calendar's timezone."),
+		),
+		mcp.WithString("working_hours",
+			mcp.Description("Daily range meetings must fall within, as HH:MM-HH:MM. Defaults to each person's configured working hours. Use 00:00-24:00 for any time."),
+		),
+		mcp.WithString("preferred_days",
+			mcp.Description("Comma-separated preferred days (e.g., tue,wed,thu)."),
+		),
+		mcp.WithString("preferred_times",
+			mcp.Description("Comma-separated preferred time ranges (e.g., 09:00-12:00,14:00-16:00)."),
+		),
+		mcp.WithNumber("buffer_before_minutes",
+			mcp.Description("Free minutes required before the meeting (default: 0)."),
+		),
+		mcp.WithNumber("buffer_after_minutes",
+			mcp.Description("Free minutes required after the meeting (default: 0)."),
+		),
+		mcp.WithNumber("max_meetings_per_day",
+			mcp.Description("Skip days on which any attendee already has this many meetings (default: no limit). Back-to-back or overlapping meetings count as one."),
+		),
+		mcp.WithNumber("max_suggestions",
+			mcp.Description("Maximum number of suggestions to return (default: 5)."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'suggest_meeting_times' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		durationMinutes, err := request.RequireFloat("duration_minutes")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid duration_minutes: %v", err)), nil
+		}
+
+		var workingHours *TimeOfDayRange
+		if workingHoursStr := request.GetString("working_hours", ""); workingHoursStr != "" {
+			parsed, err := parseTimeOfDayRange(workingHoursStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid working_hours: %v", err)), nil
+			}
+			workingHours = &parsed
+		}
+
+		preferredDays, err := parseWeekdays(request.GetString("preferred_days", ""))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid preferred_days: %v", err)), nil
+		}
+
+		preferredTimes, err := parseTimeOfDayRanges(request.GetString("preferred_times", ""))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid preferred_times: %v", err)), nil
+		}
+
+		suggestions, err := tm.service.SuggestMeetingTimes(ctx, &MeetingSuggestionRequest{
+			Attendees:         splitAndTrim(request.GetString("attendees", "")),
+			OptionalAttendees: splitAndTrim(request.GetString("optional_attendees", "")),
+			Duration:          time.Duration(durationMinutes) * time.Minute,
+			StartTime:         startTime,
+			EndTime:           endTime,
+			TimeZone:          request.GetString("timezone", ""),
+			WorkingHours:      workingHours,
+			PreferredDays:     preferredDays,
+			PreferredTimes:    preferredTimes,
+			BufferBefore:      time.Duration(request.GetFloat("buffer_before_minutes", 0)) * time.Minute,
+			BufferAfter:       time.Duration(request.GetFloat("buffer_after_minutes", 0)) * time.Minute,
+			MaxMeetingsPerDay: int(request.GetFloat("max_meetings_per_day", 0)),
+			MaxSuggestions:    int(request.GetFloat("max_suggestions", DefaultMaxSuggestions)),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to suggest meeting times: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(suggestions, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerFindConflictsTool registers the conflict audit tool
+func (tm *ToolManager) registerFindConflictsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("find_conflicts",
+		mcp.WithDescription("Audits one or more calendars over a time range and reports every pair of overlapping accepted events, runs of back-to-back events without a real break, and events outside working hours. Useful for weekly calendar hygiene reviews."),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("The start of the time range, in RFC3339 format (e.g., 2024-07-22T00:00:00Z)."),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("The end of the time range, in RFC3339 format (e.g., 2024-07-29T00:00:00Z)."),
+		),
+		mcp.WithString("calendars",
+			mcp.Description("Comma-separated calendar IDs or email addresses to audit. Defaults to the configured calendar."),
+		),
+		mcp.WithNumber("max_chain_hours",
+			mcp.Description("Report runs of back-to-back events longer than this many hours (default: 3)."),
+		),
+		mcp.WithNumber("min_break_minutes",
+			mcp.Description("Gaps shorter than this many minutes do not break a run of back-to-back events (default: 10)."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'find_conflicts' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		startTime, endTime, result := requireTimeRange(request)
+		if result != nil {
+			return result, nil
+		}
+
+		report, err := tm.service.FindConflicts(ctx, &ConflictReportRequest{
+			StartTime:   startTime,
+			EndTime:     endTime,
+			CalendarIDs: splitAndTrim(request.GetString("calendars", "")),
+			MaxChain:    time.Duration(request.GetFloat("max_chain_hours", 0) * float64(time.Hour)),
+			MinBreak:    time.Duration(request.GetFloat("min_break_minutes", 0)) * time.Minute,
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to find conflicts: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(report, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerListRoomsTool registers the room listing tool
+func (tm *ToolManager) registerListRoomsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("list_rooms",
+		mcp.WithDescription("Lists bookable meeting rooms and other Workspace resources, optionally filtered by capacity, building and features. Give start_time and end_time to list only rooms that are free for that whole slot. Book a room by passing its email in the rooms parameter of create_calendar_event."),
+		mcp.WithNumber("min_capacity",
+			mcp.Description("Only rooms that seat at least this many people."),
+		),
+		mcp.WithString("building",
+			mcp.Description("Only rooms in this building (building ID or name as listed)."),
+		),
+		mcp.WithString("features",
+			mcp.Description("Comma-separated features every room must have (e.g., video conferencing,whiteboard)."),
+		),
+		mcp.WithString("start_time",
+			mcp.Description("Start of the slot the room must be free for, in RFC3339 format."),
+		),
+		mcp.WithString("end_time",
+			mcp.Description("End of the slot the room must be free for, in RFC3339 format."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'list_rooms' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		req := &RoomSearchRequest{
+			MinCapacity: int(request.GetFloat("min_capacity", 0)),
+			Building:    strings.TrimSpace(request.GetString("building", "")),
+			Features:    splitAndTrim(request.GetString("features", "")),
+		}
+
+		startTimeStr, endTimeStr := request.GetString("start_time", ""), request.GetString("end_time", "")
+		if startTimeStr != "" || endTimeStr != "" {
+			startTime, endTime, result := requireTimeRange(request)
+			if result != nil {
+				return result, nil
+			}
+			req.StartTime, req.EndTime = startTime, endTime
+		}
+
+		rooms, err := tm.service.ListRooms(ctx, req)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list rooms: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"rooms": rooms,
+			"count": len(rooms),
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerListBookableSlotsTool registers the bookable slots tool
+func (tm *ToolManager) registerListBookableSlotsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("list_bookable_slots",
+		mcp.WithDescription("Lists the slots of a configured appointment type that external people can currently book, honouring its booking windows, buffers, daily limit, minimum notice and booking horizon."),
+		mcp.WithString("appointment_type",
+			mcp.Required(),
+			mcp.Description("Name of the appointment type, as configured in GOOGLE_CALENDAR_APPOINTMENT_TYPES."),
+		),
+		mcp.WithString("start_time",
+			mcp.Description("Only slots from this time, in RFC3339 format (default: now)."),
+		),
+		mcp.WithString("end_time",
+			mcp.Description("Only slots until this time, in RFC3339 format (default: the appointment type's booking horizon)."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'list_bookable_slots' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		appointmentType, err := request.RequireString("appointment_type")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid appointment_type: %v", err)), nil
+		}
+
+		var startTime, endTime time.Time
+		if startTimeStr := request.GetString("start_time", ""); startTimeStr != "" {
+			if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid start_time format. Please use RFC3339 format: %v", err)), nil
+			}
+		}
+		if endTimeStr := request.GetString("end_time", ""); endTimeStr != "" {
+			if endTime, err = time.Parse(time.RFC3339, endTimeStr); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid end_time format. Please use RFC3339 format: %v", err)), nil
+			}
+		}
+
+		slots, err := tm.service.ListBookableSlots(ctx, appointmentType, startTime, endTime)
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list bookable slots: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"appointment_type": appointmentType,
+			"slots":            slots,
+			"count":            len(slots),
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerBookSlotTool registers the slot booking tool
+func (tm *ToolManager) registerBookSlotTool(s *server.MCPServer) {
+	tool := mcp.NewTool("book_slot",
+		mcp.WithDescription("Books a slot from list_bookable_slots for an external attendee and sends them an invitation. Availability is re-checked when booking; if the slot has been taken meanwhile, nothing is booked and a conflict error is returned."),
+		mcp.WithString("appointment_type",
+			mcp.Required(),
+			mcp.Description("Name of the appointment type, as configured in GOOGLE_CALENDAR_APPOINTMENT_TYPES."),
+		),
+		mcp.WithString("start_time",
+			mcp.Required(),
+			mcp.Description("Start time of the slot to book, exactly as returned by list_bookable_slots (RFC3339)."),
+		),
+		mcp.WithString("attendee_email",
+			mcp.Required(),
+			mcp.Description("Email address of the person booking the slot."),
+		),
+		mcp.WithString("attendee_name",
+			mcp.Description("Name of the person booking the slot, used in the event title."),
+		),
+		mcp.WithString("notes",
+			mcp.Description("Notes from the person booking, added to the event description."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'book_slot' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		appointmentType, err := request.RequireString("appointment_type")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid appointment_type: %v", err)), nil
+		}
+
+		startTimeStr, err := request.RequireString("start_time")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid start_time: %v", err)), nil
+		}
+
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid start_time format. Please use RFC3339 format: %v", err)), nil
+		}
+
+		attendeeEmail, err := request.RequireString("attendee_email")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid attendee_email: %v", err)), nil
+		}
+
+		event, err := tm.service.BookSlot(ctx, &BookingRequest{
+			AppointmentType: appointmentType,
+			StartTime:       startTime,
+			AttendeeEmail:   attendeeEmail,
+			AttendeeName:    request.GetString("attendee_name", ""),
+			Notes:           request.GetString("notes", ""),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to book slot: %v", err)), nil
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Successfully booked '%s'", event.Summary),
+			"event":   event,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// registerRescheduleEventTool registers the rescheduling assistant tool
+func (tm *ToolManager) registerRescheduleEventTool(s *server.MCPServer) {
+	tool := mcp.NewTool("reschedule_event",
+		mcp.WithDescription("Helps move an event to a new time. Without new_start_time, proposes ranked times of the same length when you and all required attendees are free; optional attendees only lower a time's score. Call again with new_start_time set to a chosen proposal to move the event and notify the attendees."),
+		mcp.WithString("event_id",
+			mcp.Required(),
+			mcp.Description("The ID of the event to reschedule."),
+		),
+		mcp.WithString("new_start_time",
+			mcp.Description("Confirms the move: the new start time in RFC3339 format, usually one of the proposed suggestions. The event keeps its duration."),
+		),
+		mcp.WithString("start_time",
+			mcp.Description("The start of the search window, in RFC3339 format. Defaults to now."),
+		),
+		mcp.WithString("end_time",
+			mcp.Description("The end of the search window, in RFC3339 format. Defaults to 7 days after the start."),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone for working hours and preferences (e.g., Europe/London). Defaults to the calendar's timezone."),
+		),
+		mcp.WithString("working_hours",
+			mcp.Description("Daily range the new time must fall within, as HH:MM-HH:MM. Defaults to each person's configured working hours."),
+		),
+		mcp.WithString("preferred_days",
+			mcp.Description("Comma-separated preferred days (e.g., tue,wed,thu)."),
+		),
+		mcp.WithString("preferred_times",
+			mcp.Description("Comma-separated preferred time ranges (e.g., 09:00-12:00,14:00-16:00)."),
+		),
+		mcp.WithNumber("max_suggestions",
+			mcp.Description("Maximum number of proposed times to return (default: 5)."),
+		),
+		mcp.WithBoolean("allow_conflicts",
+			mcp.Description("Move the event even if you or a required attendee is busy at the new time (default: false)."),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		log.Printf("Received call to 'reschedule_event' with request: %+v", request)
+
+		// Check if service is available
+		if result := tm.checkServiceAvailability(); result != nil {
+			return result, nil
+		}
+
+		eventID, err := request.RequireString("event_id")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid event_id: %v", err)), nil
+		}
+
+		if newStartTimeStr := request.GetString("new_start_time", ""); newStartTimeStr != "" {
+			newStartTime, err := time.Parse(time.RFC3339, newStartTimeStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid new_start_time format. Please use RFC3339 format: %v", err)), nil
+			}
+
+			event, err := tm.service.RescheduleEvent(ctx, eventID, newStartTime, request.GetBool("allow_conflicts", false))
+			if err != nil {
+				if calErr, ok := err.(CalendarError); ok {
+					return mcp.NewToolResultError(calErr.Error()), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to reschedule event: %v", err)), nil
+			}
+
+			response, _ := json.MarshalIndent(map[string]interface{}{
+				"success": true,
+				"message": fmt.Sprintf("Successfully rescheduled '%s' and notified the attendees", event.Summary),
+				"event":   event,
+			}, "", "  ")
+
+			return mcp.NewToolResultText(string(response)), nil
+		}
+
+		var startTime, endTime time.Time
+		if startTimeStr := request.GetString("start_time", ""); startTimeStr != "" {
+			if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid start_time format. Please use RFC3339 format: %v", err)), nil
+			}
+		}
+		if endTimeStr := request.GetString("end_time", ""); endTimeStr != "" {
+			if endTime, err = time.Parse(time.RFC3339, endTimeStr); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid end_time format. Please use RFC3339 format: %v", err)), nil
+			}
+		}
+
+		var workingHours *TimeOfDayRange
+		if workingHoursStr := request.GetString("working_hours", ""); workingHoursStr != "" {
+			parsed, err := parseTimeOfDayRange(workingHoursStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid working_hours: %v", err)), nil
+			}
+			workingHours = &parsed
+		}
+
+		preferredDays, err := parseWeekdays(request.GetString("preferred_days", ""))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid preferred_days: %v", err)), nil
+		}
+
+		preferredTimes, err := parseTimeOfDayRanges(request.GetString("preferred_times", ""))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid preferred_times: %v", err)), nil
+		}
+
+		proposal, err := tm.service.ProposeReschedule(ctx, &RescheduleRequest{
+			EventID:        eventID,
+			StartTime:      startTime,
+			EndTime:        endTime,
+			TimeZone:       request.GetString("timezone", ""),
+			WorkingHours:   workingHours,
+			PreferredDays:  preferredDays,
+			PreferredTimes: preferredTimes,
+			MaxSuggestions: int(request.GetFloat("max_suggestions", DefaultMaxSuggestions)),
+		})
+		if err != nil {
+			if calErr, ok := err.(CalendarError); ok {
+				return mcp.NewToolResultError(calErr.Error()), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to propose new times: %v", err)), nil
+		}
+
+		message := "No free times found; try a wider search window or different working hours"
+		if len(proposal.Suggestions) > 0 {
+			message = "Call reschedule_event again with new_start_time set to the chosen suggestion to move the event"
+		}
+
+		response, _ := json.MarshalIndent(map[string]interface{}{
+			"proposal": proposal,
+			"message":  message,
+		}, "", "  ")
+
+		return mcp.NewToolResultText(string(response)), nil
+	})
+}
+
+// Helper functions
+
+// checkServiceAvailability checks if the calendar service is available
+func (tm *ToolManager) checkServiceAvailability() *mcp.CallToolResult {
+	if tm.service == nil {
+		return mcp.NewToolResultError("Calendar service unavailable. Please check your Google Calendar credentials configuration.")
+	}
+	return nil
+}
+
+// requireTimeRange parses the required start_time and end_time parameters.
+// On failure it returns a tool error result instead of an error.
+func requireTimeRange(request mcp.CallToolRequest) (time.Time, time.Time, *mcp.CallToolResult) {
+	startTimeStr, err := request.RequireString("start_time")
+	if err != nil {
+		return time.Time{}, time.Time{}, mcp.NewToolResultError(fmt.Sprintf("Invalid start_time: %v", err))
+	}
+
+	endTimeStr, err := request.RequireString("end_time")
+	if err != nil {
+		return time.Time{}, time.Time{}, mcp.NewToolResultError(fmt.Sprintf("Invalid end_time: %v", err))
+	}
+
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, mcp.NewToolResultError(fmt.Sprintf("Invalid start_time format. Please use RFC3339 format: %v", err))
+	}
+
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, mcp.NewToolResultError(fmt.Sprintf("Invalid end_time format. Please use RFC3339 format: %v", err))
+	}
+
+	return startTime, endTime, nil
+}
+
+// parseMaxResults parses the max_results parameter with a default value
+func parseMaxResults(request mcp.CallToolRequest) int {
+	maxResultsFloat := request.GetFloat("max_results", 0)
+	if maxResults := int(maxResultsFloat); maxResults > 0 && maxResults <= 250 {
+		return maxResults
+	}
+	return DefaultMaxResults
+}
+
+// splitAndTrim splits a comma-separated list and drops empty entries
+func splitAndTrim(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ParseReminders parses a comma-separated list of method:minutes reminder overrides
+func ParseReminders(remindersStr string) ([]EventReminder, error) {
+	var reminders []EventReminder
+	for _, part := range strings.Split(remindersStr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		method, minutesStr, found := strings.Cut(part, ":")
+		if !found {
+			return nil, fmt.Errorf("expected method:minutes, got %q", part)
+		}
+
+		minutes, err := strconv.Atoi(strings.TrimSpace(minutesStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid minutes in %q", part)
+		}
+
+		reminders = append(reminders, EventReminder{
+			Method:  strings.ToLower(strings.TrimSpace(method)),
+			Minutes: minutes,
+		})
+	}
+	return reminders, nil
+}
+
+// parseAttachments parses a JSON array of attachments
+func parseAttachments(attachmentsStr string) ([]EventAttachment, error) {
+	attachments := []EventAttachment{}
+	if err := json.Unmarshal([]byte(attachmentsStr), &attachments); err != nil {
+		return nil, fmt.Errorf("expected a JSON array of attachments: %v", err)
+	}
+	return attachments, nil
+}
+
+// ParseKeyValuePairs parses a comma-separated list of key=value pairs
+func ParseKeyValuePairs(pairsStr string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	for _, part := range strings.Split(pairsStr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("expected key=value, got %q", part)
+		}
+		pairs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return pairs, nil
+}
+
+// parseExtendedProperties parses the private_properties and shared_properties parameters.
+// It returns nil if neither parameter is set.
+func parseExtendedProperties(request mcp.CallToolRequest) (*ExtendedProperties, error) {
+	filter, err := parsePropertyFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	if filter.PrivateProperties == nil && filter.SharedProperties == nil {
+		return nil, nil
+	}
+	return &ExtendedProperties{
+		Private: filter.PrivateProperties,
+		Shared:  filter.SharedProperties,
+	}, nil
+}
+
+// parsePropertyFilter parses the private_properties and shared_properties parameters
+func parsePropertyFilter(request mcp.CallToolRequest) (PropertyFilter, error) {
+	var filter PropertyFilter
+
+	if privateStr := request.GetString("private_properties", ""); privateStr != "" {
+		private, err := ParseKeyValuePairs(privateStr)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid private_properties: %v", err)
+		}
+		filter.PrivateProperties = private
+	}
+
+	if sharedStr := request.GetString("shared_properties", ""); sharedStr != "" {
+		shared, err := ParseKeyValuePairs(sharedStr)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid shared_properties: %v", err)
+		}
+		filter.SharedProperties = shared
+	}
+
+	return filter, nil
 }
 
 // formatErrorResponse formats an error response for MCP tools
@@ -0,0 +1,224 @@
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultWorkingDays is used when a working hours spec does not list any days
+var defaultWorkingDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// defaultWorkingDayHours is the working day used when none is configured
+var defaultWorkingDayHours = TimeOfDayRange{Start: 9 * 60, End: 17 * 60}
+
+// loadWorkingHoursConfig reads working hours from inline JSON or a JSON file path
+func loadWorkingHoursConfig(value string) (*WorkingHoursConfig, error) {
+	data := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "{") {
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read working hours file: %w", err)
+		}
+		data = content
+	}
+
+	var config WorkingHoursConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid working hours JSON: %w", err)
+	}
+
+	return &config, nil
+}
+
+// WorkingHoursFor returns the working hours for a calendar. The configured
+// calendar uses the default spec; people without their own entry fall back to
+// the default spec, and to Monday to Friday 09:00-17:00 if none is configured.
+func (c *CalendarConfig) WorkingHoursFor(calendarID string) (*WorkingHours, error) {
+	var defaultSpec, spec *WorkingHoursSpec
+	if c.WorkingHours != nil {
+		defaultSpec = c.WorkingHours.Default
+		for person, personSpec := range c.WorkingHours.People {
+			if strings.EqualFold(person, calendarID) {
+				spec = personSpec
+				break
+			}
+		}
+	}
+	if spec == nil {
+		spec = defaultSpec
+	}
+	if spec == nil {
+		spec = &WorkingHoursSpec{}
+	}
+
+	// Timezones fall back from the person to the default spec to the calendar
+	timeZone := c.TimeZone
+	if defaultSpec != nil && defaultSpec.TimeZone != "" {
+		timeZone = defaultSpec.TimeZone
+	}
+	if spec.TimeZone != "" {
+		timeZone = spec.TimeZone
+	}
+
+	hours, err := ResolveWorkingHours(spec, timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("working hours for %s: %w", calendarID, err)
+	}
+	return hours, nil
+}
+
+// ResolveWorkingHours parses a working hours spec, using timeZone if the spec has none
+func ResolveWorkingHours(spec *WorkingHoursSpec, timeZone string) (*WorkingHours, error) {
+	if spec.TimeZone != "" {
+		timeZone = spec.TimeZone
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timeZone, err)
+	}
+
+	hours := &WorkingHours{Location: loc, Days: make(map[time.Weekday][]TimeOfDayRange)}
+
+	if len(spec.Days) == 0 {
+		for _, day := range defaultWorkingDays {
+			hours.Days[day] = []TimeOfDayRange{defaultWorkingDayHours}
+		}
+	}
+	for name, rangeStrs := range spec.Days {
+		day, ok := weekdayNames[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q", name)
+		}
+		for _, rangeStr := range rangeStrs {
+			r, err := parseTimeOfDayRange(rangeStr)
+			if err != nil {
+				return nil, err
+			}
+			hours.Days[day] = append(hours.Days[day], r)
+		}
+	}
+
+	var lunch *TimeOfDayRange
+	if spec.Lunch != "" {
+		r, err := parseTimeOfDayRange(spec.Lunch)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lunch: %w", err)
+		}
+		lunch = &r
+	}
+
+	for day, ranges := range hours.Days {
+		ranges = mergeTimeOfDayRanges(ranges)
+		if lunch != nil {
+			ranges = subtractTimeOfDayRange(ranges, *lunch)
+		}
+		hours.Days[day] = ranges
+	}
+
+	return hours, nil
+}
+
+// Intervals returns the working intervals overlapping [startTime, endTime), clipped to it
+func (h *WorkingHours) Intervals(startTime, endTime time.Time) []TimeSlot {
+	intervals := []TimeSlot{}
+
+	// Start a day early so a range crossing into startTime's local day is not missed
+	first := startTime.In(h.Location).AddDate(0, 0, -1)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, h.Location)
+	for ; day.Before(endTime); day = day.AddDate(0, 0, 1) {
+		for _, r := range h.Days[day.Weekday()] {
+			start := time.Date(day.Year(), day.Month(), day.Day(), r.Start/60, r.Start%60, 0, 0, h.Location)
+			end := time.Date(day.Year(), day.Month(), day.Day(), r.End/60, r.End%60, 0, 0, h.Location)
+			if start.Before(startTime) {
+				start = startTime
+			}
+			if end.After(endTime) {
+				end = endTime
+			}
+			if start.Before(end) {
+				intervals = append(intervals, TimeSlot{Start: start, End: end, Free: true})
+			}
+		}
+	}
+
+	return intervals
+}
+
+// clipToWorkingHours splits free slots at working hour boundaries, marking the
+// parts outside working hours as out of hours. Busy slots are left unchanged.
+func clipToWorkingHours(slots []TimeSlot, working []TimeSlot) []TimeSlot {
+	var clipped []TimeSlot
+
+	for _, slot := range slots {
+		if !slot.Free {
+			clipped = append(clipped, slot)
+			continue
+		}
+
+		cursor := slot.Start
+		for _, w := range working {
+			start, end := w.Start, w.End
+			if start.Before(cursor) {
+				start = cursor
+			}
+			if end.After(slot.End) {
+				end = slot.End
+			}
+			if !start.Before(end) {
+				continue
+			}
+			if cursor.Before(start) {
+				clipped = append(clipped, TimeSlot{Start: cursor, End: start, OutOfHours: true})
+			}
+			clipped = append(clipped, TimeSlot{Start: start, End: end, Free: true})
+			cursor = end
+		}
+		if cursor.Before(slot.End) {
+			clipped = append(clipped, TimeSlot{Start: cursor, End: slot.End, OutOfHours: true})
+		}
+	}
+
+	return clipped
+}
+
+// mergeTimeOfDayRanges sorts ranges and merges overlapping ones
+func mergeTimeOfDayRanges(ranges []TimeOfDayRange) []TimeOfDayRange {
+	sorted := append([]TimeOfDayRange{}, ranges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	var merged []TimeOfDayRange
+	for _, r := range sorted {
+		if last := len(merged) - 1; last >= 0 && r.Start <= merged[last].End {
+			if r.End > merged[last].End {
+				merged[last].End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// subtractTimeOfDayRange removes gap from each range, splitting ranges it falls inside
+func subtractTimeOfDayRange(ranges []TimeOfDayRange, gap TimeOfDayRange) []TimeOfDayRange {
+	var result []TimeOfDayRange
+	for _, r := range ranges {
+		if gap.End <= r.Start || gap.Start >= r.End {
+			result = append(result, r)
+			continue
+		}
+		if r.Start < gap.Start {
+			result = append(result, TimeOfDayRange{Start: r.Start, End: gap.Start})
+		}
+		if gap.End < r.End {
+			result = append(result, TimeOfDayRange{Start: gap.End, End: r.End})
+		}
+	}
+	return result
+}
@@ -2,8 +2,11 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"time"
 
 	"google_cal_mcp_golang/calendar"
 
@@ -35,6 +38,11 @@ func main() {
 		calendarService = nil
 	}
 
+	// Run a one-off command instead of the server if one was given
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(context.Background(), calendarService, os.Args[1:]))
+	}
+
 	// Create a new MCP server
 	s := server.NewMCPServer(
 		config.ServerName,
@@ -115,3 +123,92 @@ func addCalculatorTool(s *server.MCPServer) {
 		return mcp.NewToolResultText(fmt.Sprintf("%.2f", result)), nil
 	})
 }
+
+// commandUsage describes the one-off commands accepted instead of starting the server
+const commandUsage = `Usage:
+  calendar-mcp-server                                      Start the MCP server on stdio
+  calendar-mcp-server import-ics <file>                    Import events from an .ics file
+  calendar-mcp-server export-ics <start> <end> [file]      Export events to .ics (RFC3339 times; stdout if no file)`
+
+// runCommand runs a one-off command and returns the process exit code
+func runCommand(ctx context.Context, service calendar.CalendarService, args []string) int {
+	switch args[0] {
+	case "help", "-h", "--help":
+		fmt.Println(commandUsage)
+		return 0
+	case "import-ics", "export-ics":
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n%s\n", args[0], commandUsage)
+		return 2
+	}
+
+	if service == nil {
+		fmt.Fprintln(os.Stderr, "Calendar service is not available. Please check your credentials configuration.")
+		return 1
+	}
+
+	switch {
+	case args[0] == "import-ics" && len(args) == 2:
+		return importICS(ctx, service, args[1])
+	case args[0] == "export-ics" && (len(args) == 3 || len(args) == 4):
+		return exportICS(ctx, service, args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, commandUsage)
+		return 2
+	}
+}
+
+// importICS imports an .ics file and prints the per-event results
+func importICS(ctx context.Context, service calendar.CalendarService, path string) int {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read %s: %v\n", path, err)
+		return 1
+	}
+
+	result, err := service.ImportICS(ctx, string(data))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Import failed: %v\n", err)
+		return 1
+	}
+
+	output, _ := json.MarshalIndent(result, "", "  ")
+	fmt.Println(string(output))
+
+	if result.Failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// exportICS exports events in a time range to a file, or to stdout if no file is given
+func exportICS(ctx context.Context, service calendar.CalendarService, args []string) int {
+	startTime, err := time.Parse(time.RFC3339, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid start time. Please use RFC3339 format: %v\n", err)
+		return 2
+	}
+
+	endTime, err := time.Parse(time.RFC3339, args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid end time. Please use RFC3339 format: %v\n", err)
+		return 2
+	}
+
+	data, err := service.ExportICS(ctx, startTime, endTime)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+		return 1
+	}
+
+	if len(args) == 2 {
+		fmt.Print(data)
+		return 0
+	}
+
+	if err := os.WriteFile(args[2], []byte(data), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write %s: %v\n", args[2], err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,290 @@
+package tests
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+)
+
+func TestApplyAvailabilityOptions(t *testing.T) {
+	// Monday 22 July 09:00-17:00 with busy events 10:00-11:00 and 11:05-12:00
+	timeline := []calendar.TimeSlot{
+		{Start: at(22, 9, 0), End: at(22, 10, 0), Free: true},
+		{Start: at(22, 10, 0), End: at(22, 11, 0)},
+		{Start: at(22, 11, 0), End: at(22, 11, 5), Free: true},
+		{Start: at(22, 11, 5), End: at(22, 12, 0)},
+		{Start: at(22, 12, 0), End: at(22, 17, 0), Free: true},
+	}
+
+	tests := []struct {
+		name string
+		req  calendar.AvailabilityRequest
+		free []calendar.TimeSlot
+	}{
+		{
+			name: "no options",
+			req:  calendar.AvailabilityRequest{},
+			free: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 11, 0), End: at(22, 11, 5)},
+				{Start: at(22, 12, 0), End: at(22, 17, 0)},
+			},
+		},
+		{
+			name: "minimum duration drops the 5 minute gap",
+			req:  calendar.AvailabilityRequest{MinSlotDuration: 15 * time.Minute},
+			free: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 12, 0), End: at(22, 17, 0)},
+			},
+		},
+		{
+			name: "buffer pads busy events and closes the gap",
+			req:  calendar.AvailabilityRequest{Buffer: 10 * time.Minute},
+			free: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 9, 50)},
+				{Start: at(22, 12, 10), End: at(22, 17, 0)},
+			},
+		},
+		{
+			name: "bookable slots are aligned to the hour",
+			req:  calendar.AvailabilityRequest{Buffer: 10 * time.Minute, SlotSize: time.Hour},
+			free: []calendar.TimeSlot{
+				{Start: at(22, 13, 0), End: at(22, 14, 0)},
+				{Start: at(22, 14, 0), End: at(22, 15, 0)},
+				{Start: at(22, 15, 0), End: at(22, 16, 0)},
+				{Start: at(22, 16, 0), End: at(22, 17, 0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			req.StartTime = at(22, 9, 0)
+			req.EndTime = at(22, 17, 0)
+
+			var free []calendar.TimeSlot
+			for _, slot := range calendar.ApplyAvailabilityOptions(timeline, &req, nil, time.UTC) {
+				if slot.Free {
+					free = append(free, slot)
+				}
+			}
+
+			if len(free) != len(tt.free) {
+				t.Fatalf("Expected %d free slots, got: %+v", len(tt.free), free)
+			}
+			for i, want := range tt.free {
+				if !free[i].Start.Equal(want.Start) || !free[i].End.Equal(want.End) {
+					t.Errorf("Expected free slot %v - %v, got: %v - %v", want.Start, want.End, free[i].Start, free[i].End)
+				}
+			}
+		})
+	}
+}
+
+func TestIsBusyEvent(t *testing.T) {
+	withResponse := func(status string) []*googlecalendar.EventAttendee {
+		return []*googlecalendar.EventAttendee{
+			{Email: "me@example.com", Self: true, ResponseStatus: status},
+			{Email: "other@example.com", ResponseStatus: calendar.ResponseStatusAccepted},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		event *googlecalendar.Event
+		rules calendar.BusyRules
+		busy  bool
+	}{
+		{"confirmed", &googlecalendar.Event{Status: "confirmed"}, calendar.BusyRules{}, true},
+		{"cancelled", &googlecalendar.Event{Status: "cancelled"}, calendar.BusyRules{CountDeclined: true, CountTransparent: true}, false},
+		{"working location", &googlecalendar.Event{EventType: "workingLocation"}, calendar.BusyRules{}, false},
+		{"transparent", &googlecalendar.Event{Transparency: "transparent"}, calendar.BusyRules{}, false},
+		{"transparent counted", &googlecalendar.Event{Transparency: "transparent"}, calendar.BusyRules{CountTransparent: true}, true},
+		{"declined", &googlecalendar.Event{Attendees: withResponse("declined")}, calendar.BusyRules{}, false},
+		{"declined counted", &googlecalendar.Event{Attendees: withResponse("declined")}, calendar.BusyRules{CountDeclined: true}, true},
+		{"unanswered", &googlecalendar.Event{Attendees: withResponse("needsAction")}, calendar.BusyRules{}, true},
+		{"tentative", &googlecalendar.Event{Attendees: withResponse("tentative")}, calendar.BusyRules{}, true},
+		{"tentative as free", &googlecalendar.Event{Attendees: withResponse("tentative")}, calendar.BusyRules{TentativeAsFree: true}, false},
+		{"tentative status as free", &googlecalendar.Event{Status: "tentative"}, calendar.BusyRules{TentativeAsFree: true}, false},
+	}
+
+	for _, tt := range tests {
+		if got := calendar.IsBusyEvent(tt.event, tt.rules); got != tt.busy {
+			t.Errorf("%s: expected busy=%t, got %t", tt.name, tt.busy, got)
+		}
+	}
+}
+
+func TestCalculateFreeTimeSlots(t *testing.T) {
+	slot := func(startHour, startMinute, endHour, endMinute int) calendar.TimeSlot {
+		return calendar.TimeSlot{Start: at(22, startHour, startMinute), End: at(22, endHour, endMinute)}
+	}
+	free := func(s calendar.TimeSlot) calendar.TimeSlot {
+		s.Free = true
+		return s
+	}
+
+	tests := []struct {
+		name     string
+		busy     []calendar.TimeSlot
+		timeline []calendar.TimeSlot
+	}{
+		{
+			name:     "no events",
+			timeline: []calendar.TimeSlot{free(slot(9, 0, 17, 0))},
+		},
+		{
+			name:     "overlapping events are merged",
+			busy:     []calendar.TimeSlot{slot(10, 0, 11, 0), slot(10, 30, 12, 0)},
+			timeline: []calendar.TimeSlot{free(slot(9, 0, 10, 0)), slot(10, 0, 12, 0), free(slot(12, 0, 17, 0))},
+		},
+		{
+			name:     "contained and duplicate events are merged",
+			busy:     []calendar.TimeSlot{slot(10, 0, 12, 0), slot(10, 30, 11, 0), slot(10, 0, 12, 0)},
+			timeline: []calendar.TimeSlot{free(slot(9, 0, 10, 0)), slot(10, 0, 12, 0), free(slot(12, 0, 17, 0))},
+		},
+		{
+			name:     "back to back events are merged",
+			busy:     []calendar.TimeSlot{slot(11, 0, 12, 0), slot(10, 0, 11, 0)},
+			timeline: []calendar.TimeSlot{free(slot(9, 0, 10, 0)), slot(10, 0, 12, 0), free(slot(12, 0, 17, 0))},
+		},
+		{
+			name:     "unsorted events",
+			busy:     []calendar.TimeSlot{slot(15, 0, 16, 0), slot(10, 0, 11, 0)},
+			timeline: []calendar.TimeSlot{free(slot(9, 0, 10, 0)), slot(10, 0, 11, 0), free(slot(11, 0, 15, 0)), slot(15, 0, 16, 0), free(slot(16, 0, 17, 0))},
+		},
+		{
+			name:     "events are clipped to the range",
+			busy:     []calendar.TimeSlot{slot(8, 0, 9, 30), slot(16, 30, 18, 0), slot(7, 0, 8, 0)},
+			timeline: []calendar.TimeSlot{slot(9, 0, 9, 30), free(slot(9, 30, 16, 30)), slot(16, 30, 17, 0)},
+		},
+		{
+			name:     "event covering the whole range",
+			busy:     []calendar.TimeSlot{slot(0, 0, 23, 0)},
+			timeline: []calendar.TimeSlot{slot(9, 0, 17, 0)},
+		},
+		{
+			name:     "zero length events are ignored",
+			busy:     []calendar.TimeSlot{slot(12, 0, 12, 0)},
+			timeline: []calendar.TimeSlot{free(slot(9, 0, 17, 0))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := calendar.CalculateFreeTimeSlots(at(22, 9, 0), at(22, 17, 0), tt.busy)
+
+			if len(timeline) != len(tt.timeline) {
+				t.Fatalf("Expected %d slots, got: %+v", len(tt.timeline), timeline)
+			}
+			for i, want := range tt.timeline {
+				got := timeline[i]
+				if !got.Start.Equal(want.Start) || !got.End.Equal(want.End) || got.Free != want.Free {
+					t.Errorf("Expected slot %v - %v (free=%t), got: %v - %v (free=%t)", want.Start, want.End, want.Free, got.Start, got.End, got.Free)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateFreeTimeSlotsInvariants(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	startTime, endTime := at(22, 0, 0), at(23, 0, 0)
+
+	for iteration := 0; iteration < 1000; iteration++ {
+		// Random events in 5 minute steps, some extending outside the range
+		busy := make([]calendar.TimeSlot, rng.Intn(12))
+		for i := range busy {
+			start := startTime.Add(time.Duration(rng.Intn(26*12)-12) * 5 * time.Minute)
+			busy[i] = calendar.TimeSlot{Start: start, End: start.Add(time.Duration(rng.Intn(48)) * 5 * time.Minute)}
+		}
+
+		timeline := calendar.CalculateFreeTimeSlots(startTime, endTime, busy)
+
+		if len(timeline) == 0 {
+			t.Fatalf("Expected a non-empty timeline for %+v", busy)
+		}
+		if !timeline[0].Start.Equal(startTime) || !timeline[len(timeline)-1].End.Equal(endTime) {
+			t.Fatalf("Expected timeline to cover the whole range, got: %+v", timeline)
+		}
+
+		for i, slot := range timeline {
+			if !slot.Start.Before(slot.End) {
+				t.Fatalf("Expected non-empty slot, got: %+v", slot)
+			}
+			if i > 0 {
+				previous := timeline[i-1]
+				if !previous.End.Equal(slot.Start) {
+					t.Fatalf("Expected contiguous, ordered, non-overlapping slots, got: %+v then %+v", previous, slot)
+				}
+				if previous.Free == slot.Free {
+					t.Fatalf("Expected adjacent slots to be merged, got: %+v then %+v", previous, slot)
+				}
+			}
+
+			// A slot is busy exactly when some event covers its midpoint
+			midpoint := slot.Start.Add(slot.End.Sub(slot.Start) / 2)
+			covered := false
+			for _, event := range busy {
+				if !midpoint.Before(event.Start) && midpoint.Before(event.End) {
+					covered = true
+					break
+				}
+			}
+			if covered == slot.Free {
+				t.Fatalf("Expected slot %+v to be free=%t for events %+v", slot, !covered, busy)
+			}
+		}
+	}
+}
+
+func TestBusySlotsFromEvents(t *testing.T) {
+	events := []*googlecalendar.Event{
+		{
+			Id:    "meeting",
+			Start: &googlecalendar.EventDateTime{DateTime: "2024-07-22T10:00:00Z"},
+			End:   &googlecalendar.EventDateTime{DateTime: "2024-07-22T11:00:00Z"},
+		},
+		{
+			Id:    "holiday",
+			Start: &googlecalendar.EventDateTime{Date: "2024-07-23"},
+			End:   &googlecalendar.EventDateTime{Date: "2024-07-24"},
+		},
+		{
+			Id:           "reminder",
+			Transparency: "transparent",
+			Start:        &googlecalendar.EventDateTime{DateTime: "not a time"},
+			End:          &googlecalendar.EventDateTime{DateTime: "not a time"},
+		},
+	}
+
+	busy, err := calendar.BusySlotsFromEvents(events, calendar.BusyRules{}, time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to convert events: %v", err)
+	}
+
+	want := []calendar.TimeSlot{
+		{Start: at(22, 10, 0), End: at(22, 11, 0)},
+		{Start: at(23, 0, 0), End: at(24, 0, 0)},
+	}
+	if len(busy) != len(want) {
+		t.Fatalf("Expected %d busy slots, got: %+v", len(want), busy)
+	}
+	for i := range want {
+		if !busy[i].Start.Equal(want[i].Start) || !busy[i].End.Equal(want[i].End) {
+			t.Errorf("Expected busy slot %v - %v, got: %v - %v", want[i].Start, want[i].End, busy[i].Start, busy[i].End)
+		}
+	}
+
+	// Invalid times are reported for events that block time
+	events[2].Transparency = ""
+	if _, err := calendar.BusySlotsFromEvents(events, calendar.BusyRules{}, time.UTC); err == nil {
+		t.Error("Expected error for an event with an invalid time")
+	}
+}
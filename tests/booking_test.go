@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+)
+
+func TestBookableSlots(t *testing.T) {
+	// Monday 22 July 2024, bookable 09:00-12:00 UTC with 30 minute slots
+	windows := &calendar.WorkingHours{
+		Location: time.UTC,
+		Days:     map[time.Weekday][]calendar.TimeOfDayRange{time.Monday: {{Start: 9 * 60, End: 12 * 60}}},
+	}
+	busy := []calendar.TimeSlot{{Start: at(22, 10, 0), End: at(22, 10, 30)}}
+	now := at(21, 12, 0)
+
+	starts := func(slots []calendar.TimeSlot) []string {
+		var times []string
+		for _, slot := range slots {
+			times = append(times, slot.Start.Format("15:04"))
+		}
+		return times
+	}
+
+	tests := []struct {
+		name        string
+		appointment calendar.AppointmentType
+		booked      []calendar.TimeSlot
+		now         time.Time
+		starts      []string
+	}{
+		{
+			name:        "busy time is skipped",
+			appointment: calendar.AppointmentType{DurationMinutes: 30},
+			starts:      []string{"09:00", "09:30", "10:30", "11:00", "11:30"},
+		},
+		{
+			name:        "buffers keep time around busy events free",
+			appointment: calendar.AppointmentType{DurationMinutes: 30, BufferBeforeMinutes: 15, BufferAfterMinutes: 15},
+			starts:      []string{"09:00", "11:00", "11:30"},
+		},
+		{
+			name:        "slot step",
+			appointment: calendar.AppointmentType{DurationMinutes: 45, SlotStepMinutes: 15},
+			starts:      []string{"09:00", "09:15", "10:30", "10:45", "11:00", "11:15"},
+		},
+		{
+			name:        "minimum notice",
+			appointment: calendar.AppointmentType{DurationMinutes: 30, MinNoticeMinutes: 20},
+			now:         at(22, 10, 50),
+			starts:      []string{"11:30"},
+		},
+		{
+			name:        "daily cap reached",
+			appointment: calendar.AppointmentType{DurationMinutes: 30, MaxPerDay: 1},
+			booked:      []calendar.TimeSlot{{Start: at(22, 10, 0), End: at(22, 10, 30)}},
+		},
+		{
+			name:        "beyond the booking horizon",
+			appointment: calendar.AppointmentType{DurationMinutes: 30, MaxDaysAhead: 1},
+			now:         at(20, 12, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := now
+			if !tt.now.IsZero() {
+				current = tt.now
+			}
+
+			slots := calendar.BookableSlots(&tt.appointment, windows, busy, tt.booked, at(22, 0, 0), at(23, 0, 0), current)
+
+			got := starts(slots)
+			if len(got) != len(tt.starts) {
+				t.Fatalf("Expected slots %v, got: %v", tt.starts, got)
+			}
+			for i := range got {
+				if got[i] != tt.starts[i] {
+					t.Errorf("Expected slots %v, got: %v", tt.starts, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestAppointmentTypesConfig(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("GOOGLE_CALENDAR_CREDENTIALS_JSON", `{"type": "service_account", "project_id": "test"}`)
+	os.Setenv("GOOGLE_CALENDAR_APPOINTMENT_TYPES", `{
+	  "intro-call": {"title": "Intro call", "duration_minutes": 30, "timezone": "Europe/London", "windows": {"tuesday": ["14:00-17:00"]}},
+	  "consultation": {"title": "Consultation", "duration_minutes": 60}
+	}`)
+
+	config, err := calendar.LoadConfig()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	windows, err := config.AppointmentWindows(config.AppointmentTypes["intro-call"])
+	if err != nil {
+		t.Fatalf("Failed to resolve windows: %v", err)
+	}
+	if windows.Location.String() != "Europe/London" || len(windows.Days) != 1 || windows.Days[time.Tuesday][0].String() != "14:00-17:00" {
+		t.Errorf("Expected Tuesday 14:00-17:00 London time, got: %s %v", windows.Location, windows.Days)
+	}
+
+	// Without windows the owner's working hours apply
+	windows, err = config.AppointmentWindows(config.AppointmentTypes["consultation"])
+	if err != nil {
+		t.Fatalf("Failed to resolve windows: %v", err)
+	}
+	if len(windows.Days) != 5 {
+		t.Errorf("Expected Monday to Friday working hours, got: %v", windows.Days)
+	}
+
+	invalid := []string{
+		`{"intro-call": {"title": "Intro call"}}`,
+		`{"intro-call": {"duration_minutes": 30, "max_per_day": -1}}`,
+		`{"intro-call": {"duration_minutes": 30, "windows": {"caturday": ["09:00-10:00"]}}}`,
+	}
+	for _, value := range invalid {
+		os.Setenv("GOOGLE_CALENDAR_APPOINTMENT_TYPES", value)
+		if _, err := calendar.LoadConfig(); err == nil {
+			t.Errorf("Expected error for appointment types %s", value)
+		}
+	}
+}
@@ -39,6 +39,10 @@ func TestConfigValidation(t *testing.T) {
 	if config.TimeZone != "UTC" {
 		t.Errorf("Expected default timezone 'UTC', got: %s", config.TimeZone)
 	}
+
+	if config.TimeZoneExplicit {
+		t.Error("Expected defaulted timezone not to be marked explicit")
+	}
 }
 
 func TestEnvFileLoading(t *testing.T) {
@@ -79,6 +83,10 @@ DEBUG=true
 		t.Errorf("Expected timezone 'Europe/London', got: %s", config.TimeZone)
 	}
 
+	if !config.TimeZoneExplicit {
+		t.Error("Expected timezone from .env to be marked explicit")
+	}
+
 	if !config.Debug {
 		t.Error("Expected debug mode to be true")
 	}
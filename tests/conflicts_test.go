@@ -0,0 +1,222 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+)
+
+func TestOverlappingEvents(t *testing.T) {
+	event := func(id, start, end string) *googlecalendar.Event {
+		return &googlecalendar.Event{
+			Id:      id,
+			Summary: "Event " + id,
+			Start:   &googlecalendar.EventDateTime{DateTime: start},
+			End:     &googlecalendar.EventDateTime{DateTime: end},
+		}
+	}
+
+	events := []*googlecalendar.Event{
+		event("before", "2024-07-22T09:00:00Z", "2024-07-22T10:00:00Z"),
+		event("overlap", "2024-07-22T10:30:00Z", "2024-07-22T11:30:00Z"),
+		event("self", "2024-07-22T10:00:00Z", "2024-07-22T11:00:00Z"),
+		event("after", "2024-07-22T11:00:00Z", "2024-07-22T12:00:00Z"),
+		{Id: "holiday", Start: &googlecalendar.EventDateTime{Date: "2024-07-22"}, End: &googlecalendar.EventDateTime{Date: "2024-07-23"}},
+		{Id: "free", Transparency: "transparent", Start: &googlecalendar.EventDateTime{DateTime: "2024-07-22T10:00:00Z"}, End: &googlecalendar.EventDateTime{DateTime: "2024-07-22T11:00:00Z"}},
+	}
+
+	conflicts, err := calendar.OverlappingEvents("primary", at(22, 10, 0), at(22, 11, 0), events, "self", time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to find overlapping events: %v", err)
+	}
+
+	// Back-to-back events, the event itself and free events do not conflict
+	var ids []string
+	for _, conflict := range conflicts {
+		ids = append(ids, conflict.EventID)
+	}
+	if strings.Join(ids, ",") != "overlap,holiday" {
+		t.Errorf("Expected conflicts with 'overlap' and 'holiday', got: %v", ids)
+	}
+
+	if got := conflicts[0].String(); got != `primary "Event overlap" (overlap) 2024-07-22T10:30:00Z to 2024-07-22T11:30:00Z` {
+		t.Errorf("Unexpected conflict description: %s", got)
+	}
+}
+
+func TestAuditEvents(t *testing.T) {
+	weekdays := map[time.Weekday][]calendar.TimeOfDayRange{}
+	for day := time.Monday; day <= time.Friday; day++ {
+		weekdays[day] = []calendar.TimeOfDayRange{{Start: 9 * 60, End: 17 * 60}}
+	}
+	workingHours := &calendar.WorkingHours{Location: time.UTC, Days: weekdays}
+
+	event := func(id string, startHour, startMinute, endHour, endMinute int) *googlecalendar.Event {
+		return &googlecalendar.Event{
+			Id:      id,
+			Summary: "Event " + id,
+			Start:   &googlecalendar.EventDateTime{DateTime: at(22, startHour, startMinute).Format(time.RFC3339)},
+			End:     &googlecalendar.EventDateTime{DateTime: at(22, endHour, endMinute).Format(time.RFC3339)},
+		}
+	}
+	declined := event("declined", 9, 30, 10, 30)
+	declined.Attendees = []*googlecalendar.EventAttendee{{Email: "me@example.com", Self: true, ResponseStatus: "declined"}}
+
+	// Monday 22 July: 08:30-09:30 and 09:00-10:00 overlap, then a run of
+	// meetings with 5 minute gaps until 13:00, a break, and a late call
+	events := []*googlecalendar.Event{
+		event("early", 8, 30, 9, 30),
+		event("standup", 9, 0, 10, 0),
+		declined,
+		event("review", 10, 5, 11, 0),
+		event("planning", 11, 5, 13, 0),
+		event("lunch-talk", 14, 0, 15, 0),
+		event("late-call", 16, 30, 18, 0),
+	}
+
+	report, err := calendar.AuditEvents("primary", true, events, workingHours, &calendar.ConflictReportRequest{})
+	if err != nil {
+		t.Fatalf("Failed to audit events: %v", err)
+	}
+
+	if len(report.Overlaps) != 1 || report.Overlaps[0].First.EventID != "early" || report.Overlaps[0].Second.EventID != "standup" {
+		t.Fatalf("Expected only 'early' and 'standup' to overlap, got: %+v", report.Overlaps)
+	}
+	if report.Overlaps[0].OverlapMinutes != 30 {
+		t.Errorf("Expected a 30 minute overlap, got: %d", report.Overlaps[0].OverlapMinutes)
+	}
+
+	if len(report.LongChains) != 1 {
+		t.Fatalf("Expected one long chain, got: %+v", report.LongChains)
+	}
+	chain := report.LongChains[0]
+	if !chain.Start.Equal(at(22, 8, 30)) || !chain.End.Equal(at(22, 13, 0)) || len(chain.Events) != 4 || chain.DurationMinutes != 270 {
+		t.Errorf("Expected a 4.5 hour chain of 4 events from 08:30, got: %+v", chain)
+	}
+
+	var outside []string
+	for _, event := range report.OutOfHours {
+		outside = append(outside, event.EventID)
+	}
+	if strings.Join(outside, ",") != "early,late-call" {
+		t.Errorf("Expected 'early' and 'late-call' outside working hours, got: %v", outside)
+	}
+
+	// A longer allowed chain reports nothing
+	report, err = calendar.AuditEvents("primary", true, events, workingHours, &calendar.ConflictReportRequest{MaxChain: 5 * time.Hour})
+	if err != nil {
+		t.Fatalf("Failed to audit events: %v", err)
+	}
+	if len(report.LongChains) != 0 {
+		t.Errorf("Expected no long chains, got: %+v", report.LongChains)
+	}
+}
+
+func TestAuditEventsColleagueCalendar(t *testing.T) {
+	workingHours := &calendar.WorkingHours{Location: time.UTC, Days: map[time.Weekday][]calendar.TimeOfDayRange{
+		time.Monday: {{Start: 9 * 60, End: 17 * 60}},
+	}}
+
+	// Bob's calendar, read by us: "me" is the authenticated user, marked as self
+	event := func(id string, startHour, endHour int, me, bob string) *googlecalendar.Event {
+		return &googlecalendar.Event{
+			Id:    id,
+			Start: &googlecalendar.EventDateTime{DateTime: at(22, startHour, 0).Format(time.RFC3339)},
+			End:   &googlecalendar.EventDateTime{DateTime: at(22, endHour, 0).Format(time.RFC3339)},
+			Attendees: []*googlecalendar.EventAttendee{
+				{Email: "me@example.com", Self: true, ResponseStatus: me},
+				{Email: "Bob@example.com", ResponseStatus: bob},
+			},
+		}
+	}
+	events := []*googlecalendar.Event{
+		event("bob-accepted", 10, 12, calendar.ResponseStatusDeclined, calendar.ResponseStatusAccepted),
+		event("bob-declined", 11, 13, calendar.ResponseStatusNeedsAction, calendar.ResponseStatusDeclined),
+		event("both-accepted", 11, 12, calendar.ResponseStatusAccepted, calendar.ResponseStatusAccepted),
+	}
+
+	report, err := calendar.AuditEvents("bob@example.com", false, events, workingHours, &calendar.ConflictReportRequest{})
+	if err != nil {
+		t.Fatalf("Failed to audit events: %v", err)
+	}
+
+	// Only Bob's responses count: the event he declined is ignored, the one we declined is not
+	if len(report.Overlaps) != 1 || report.Overlaps[0].First.EventID != "bob-accepted" || report.Overlaps[0].Second.EventID != "both-accepted" {
+		t.Errorf("Expected 'bob-accepted' and 'both-accepted' to overlap, got: %+v", report.Overlaps)
+	}
+}
+
+func TestExcludeMovedEvent(t *testing.T) {
+	event := func(id string, start, end time.Time) *googlecalendar.Event {
+		return &googlecalendar.Event{
+			Id:    id,
+			Start: &googlecalendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+			End:   &googlecalendar.EventDateTime{DateTime: end.Format(time.RFC3339)},
+		}
+	}
+
+	// The event "review" is moving away from 10:00-11:00
+	moved := &calendar.MovedEvent{
+		EventID:   "review",
+		Previous:  calendar.TimeSlot{Start: at(22, 10, 0), End: at(22, 11, 0)},
+		Calendars: []string{"me@example.com", "Alice@example.com", "bob@example.com", "erin@example.com"},
+	}
+
+	freeBusy := &calendar.FreeBusyResult{
+		StartTime: at(22, 9, 0),
+		EndTime:   at(22, 17, 0),
+		Calendars: []calendar.CalendarBusy{
+			// Alice's calendar can be read, and has her own meeting overlapping the old slot
+			{CalendarID: "alice@example.com", Busy: []calendar.TimeSlot{{Start: at(22, 10, 0), End: at(22, 11, 30)}}},
+			// Bob's only shares free/busy, with a meeting straight after
+			{CalendarID: "bob@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 10, 0), End: at(22, 11, 0)},
+				{Start: at(22, 11, 0), End: at(22, 12, 0)},
+			}},
+			// Carol is newly invited, so her busy time at 10:00 is another meeting
+			{CalendarID: "carol@example.com", Busy: []calendar.TimeSlot{{Start: at(22, 10, 0), End: at(22, 11, 0)}}},
+			{CalendarID: "dave@other.example.com", Errors: []string{"notFound"}},
+			// Erin's calendar can be read, and has back-to-back meetings after the old slot
+			{CalendarID: "erin@example.com", Busy: []calendar.TimeSlot{{Start: at(22, 10, 0), End: at(22, 14, 0)}}},
+		},
+	}
+
+	calendarEvents := map[string][]*googlecalendar.Event{
+		"alice@example.com": {
+			event("review", at(22, 10, 0), at(22, 11, 0)),
+			event("one-to-one", at(22, 10, 30), at(22, 11, 30)),
+		},
+		"erin@example.com": {
+			event("review", at(22, 10, 0), at(22, 11, 0)),
+			event("design", at(22, 12, 0), at(22, 13, 0)),
+			event("retro", at(22, 13, 0), at(22, 14, 0)),
+		},
+	}
+
+	if err := calendar.ExcludeMovedEvent(freeBusy, moved, calendarEvents, time.UTC); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Run("readable calendar keeps other events in the old slot", func(t *testing.T) {
+		checkSlots(t, freeBusy.Calendars[0].Busy, []calendar.TimeSlot{{Start: at(22, 10, 30), End: at(22, 11, 30)}})
+	})
+	t.Run("free/busy calendar has the old slot subtracted", func(t *testing.T) {
+		checkSlots(t, freeBusy.Calendars[1].Busy, []calendar.TimeSlot{{Start: at(22, 11, 0), End: at(22, 12, 0)}})
+	})
+	t.Run("calendar not on the event is unchanged", func(t *testing.T) {
+		checkSlots(t, freeBusy.Calendars[2].Busy, []calendar.TimeSlot{{Start: at(22, 10, 0), End: at(22, 11, 0)}})
+	})
+	t.Run("rebuilt busy time is merged like free/busy", func(t *testing.T) {
+		checkSlots(t, freeBusy.Calendars[4].Busy, []calendar.TimeSlot{{Start: at(22, 12, 0), End: at(22, 14, 0)}})
+	})
+	t.Run("common free time is recomputed", func(t *testing.T) {
+		checkSlots(t, freeBusy.CommonFree, []calendar.TimeSlot{
+			{Start: at(22, 9, 0), End: at(22, 10, 0)},
+			{Start: at(22, 14, 0), End: at(22, 17, 0)},
+		})
+	})
+}
@@ -0,0 +1,205 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+)
+
+func TestMergeBusySlots(t *testing.T) {
+	tests := []struct {
+		name string
+		busy []calendar.TimeSlot
+		want []calendar.TimeSlot
+	}{
+		{
+			name: "empty",
+			busy: nil,
+			want: nil,
+		},
+		{
+			name: "overlapping slots are merged",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 30)},
+				{Start: at(22, 10, 0), End: at(22, 11, 0)},
+			},
+			want: []calendar.TimeSlot{{Start: at(22, 9, 0), End: at(22, 11, 0)}},
+		},
+		{
+			name: "touching slots are merged",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 10, 0), End: at(22, 11, 0)},
+			},
+			want: []calendar.TimeSlot{{Start: at(22, 9, 0), End: at(22, 11, 0)}},
+		},
+		{
+			name: "contained slot is absorbed",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 12, 0)},
+				{Start: at(22, 10, 0), End: at(22, 11, 0)},
+			},
+			want: []calendar.TimeSlot{{Start: at(22, 9, 0), End: at(22, 12, 0)}},
+		},
+		{
+			name: "unsorted slots with a gap stay apart",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 14, 0), End: at(22, 15, 0)},
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+			},
+			want: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 14, 0), End: at(22, 15, 0)},
+			},
+		},
+		{
+			name: "empty and inverted slots are dropped",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 9, 0)},
+				{Start: at(22, 11, 0), End: at(22, 10, 0)},
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkSlots(t, calendar.MergeBusySlots(tt.busy), tt.want)
+		})
+	}
+}
+
+func TestFreeSlotsBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		busy []calendar.TimeSlot
+		want []calendar.TimeSlot
+	}{
+		{
+			name: "no busy time",
+			busy: nil,
+			want: []calendar.TimeSlot{{Start: at(22, 9, 0), End: at(22, 17, 0)}},
+		},
+		{
+			name: "gaps between overlapping and touching slots",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 10, 0), End: at(22, 11, 0)},
+				{Start: at(22, 10, 30), End: at(22, 12, 0)},
+				{Start: at(22, 12, 0), End: at(22, 13, 0)},
+				{Start: at(22, 15, 0), End: at(22, 16, 0)},
+			},
+			want: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 13, 0), End: at(22, 15, 0)},
+				{Start: at(22, 16, 0), End: at(22, 17, 0)},
+			},
+		},
+		{
+			name: "busy time outside the range is clipped",
+			busy: []calendar.TimeSlot{
+				{Start: at(22, 7, 0), End: at(22, 8, 0)},
+				{Start: at(22, 8, 0), End: at(22, 9, 30)},
+				{Start: at(22, 16, 30), End: at(22, 18, 0)},
+				{Start: at(22, 19, 0), End: at(22, 20, 0)},
+			},
+			want: []calendar.TimeSlot{{Start: at(22, 9, 30), End: at(22, 16, 30)}},
+		},
+		{
+			name: "busy for the whole range",
+			busy: []calendar.TimeSlot{{Start: at(22, 8, 0), End: at(22, 18, 0)}},
+			want: []calendar.TimeSlot{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			free := calendar.FreeSlotsBetween(at(22, 9, 0), at(22, 17, 0), tt.busy)
+			if free == nil {
+				t.Error("Expected an empty, non-nil list when there is no free time")
+			}
+			for _, slot := range free {
+				if !slot.Free {
+					t.Errorf("Expected slot %v - %v to be marked free", slot.Start, slot.End)
+				}
+			}
+			checkSlots(t, free, tt.want)
+		})
+	}
+}
+
+func TestConvertFreeBusyResponse(t *testing.T) {
+	period := func(start, end time.Time) *googlecalendar.TimePeriod {
+		return &googlecalendar.TimePeriod{Start: start.Format(time.RFC3339), End: end.Format(time.RFC3339)}
+	}
+
+	response := &googlecalendar.FreeBusyResponse{
+		Calendars: map[string]googlecalendar.FreeBusyCalendar{
+			"me@example.com": {Busy: []*googlecalendar.TimePeriod{
+				period(at(22, 8, 0), at(22, 10, 0)),
+			}},
+			"team@example.com": {},
+			"zoe@example.com": {Busy: []*googlecalendar.TimePeriod{
+				period(at(22, 12, 0), at(22, 13, 0)),
+			}},
+			"yuri@example.com": {Busy: []*googlecalendar.TimePeriod{
+				period(at(22, 13, 0), at(22, 14, 0)),
+				{Start: "not a time", End: "2024-07-22T15:00:00Z"},
+			}},
+			"external@other.example.com": {
+				Errors: []*googlecalendar.Error{{Domain: "global", Reason: "notFound"}},
+				Busy:   []*googlecalendar.TimePeriod{period(at(22, 9, 0), at(22, 17, 0))},
+			},
+		},
+		Groups: map[string]googlecalendar.FreeBusyGroup{
+			"team@example.com": {Calendars: []string{"zoe@example.com", "yuri@example.com"}},
+		},
+	}
+
+	result := calendar.ConvertFreeBusyResponse(response,
+		[]string{"me@example.com", "external@other.example.com", "team@example.com", "missing@example.com"},
+		at(22, 9, 0), at(22, 17, 0))
+
+	// Requested calendars keep their order, followed by group members sorted by ID
+	wantOrder := []string{"me@example.com", "external@other.example.com", "team@example.com", "yuri@example.com", "zoe@example.com"}
+	if len(result.Calendars) != len(wantOrder) {
+		t.Fatalf("Expected %d calendars, got: %+v", len(wantOrder), result.Calendars)
+	}
+	for i, id := range wantOrder {
+		if result.Calendars[i].CalendarID != id {
+			t.Errorf("Expected calendar %d to be %s, got: %s", i, id, result.Calendars[i].CalendarID)
+		}
+	}
+
+	if errs := result.Calendars[1].Errors; len(errs) != 1 || errs[0] != "notFound" {
+		t.Errorf("Expected the external calendar to report notFound, got: %v", errs)
+	}
+	if errs := result.Calendars[3].Errors; len(errs) != 1 {
+		t.Errorf("Expected the invalid busy period to be reported as an error, got: %v", errs)
+	}
+
+	if len(result.Groups) != 1 || result.Groups[0].GroupID != "team@example.com" || len(result.Groups[0].CalendarIDs) != 2 {
+		t.Errorf("Expected the team group expansion, got: %+v", result.Groups)
+	}
+
+	// Calendars with errors do not narrow the common free time, and busy time is clipped to the range
+	checkSlots(t, result.CommonFree, []calendar.TimeSlot{
+		{Start: at(22, 10, 0), End: at(22, 12, 0)},
+		{Start: at(22, 13, 0), End: at(22, 17, 0)},
+	})
+}
+
+// checkSlots reports slots that differ from want in number, start or end
+func checkSlots(t *testing.T, got, want []calendar.TimeSlot) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d slots, got: %+v", len(want), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("Expected slot %v - %v, got: %v - %v", want[i].Start, want[i].End, got[i].Start, got[i].End)
+		}
+	}
+}
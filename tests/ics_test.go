@@ -0,0 +1,303 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+)
+
+const sampleICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//Test//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Europe/London\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:19701025T020000\r\n" +
+	"TZOFFSETFROM:+0100\r\n" +
+	"TZOFFSETTO:+0000\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup-123@example.com\r\n" +
+	"SUMMARY:Daily standup\\, team A\r\n" +
+	"DESCRIPTION:Line one\\nLine two that is folded\r\n" +
+	"  across two lines\r\n" +
+	"DTSTART;TZID=Europe/London:20240715T093000\r\n" +
+	"DURATION:PT15M\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR\r\n" +
+	"ORGANIZER;CN=Alice:mailto:alice@example.com\r\n" +
+	"ATTENDEE;CN=\"Bob; Jr\";PARTSTAT=ACCEPTED:mailto:bob@example.com\r\n" +
+	"ATTENDEE;ROLE=OPT-PARTICIPANT;PARTSTAT=TENTATIVE:mailto:carol@example.com\r\n" +
+	"ATTENDEE;CUTYPE=ROOM:mailto:room-1@resource.example.com\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT10M\r\n" +
+	"END:VALARM\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:EMAIL\r\n" +
+	"TRIGGER:-P1D\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:holiday-1@example.com\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"DTSTART;VALUE=DATE:20240801\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:No UID\r\n" +
+	"DTSTART:20240801T100000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICS(t *testing.T) {
+	events, err := calendar.ParseICS(sampleICS, time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to parse ICS: %v", err)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got: %d", len(events))
+	}
+
+	standup := events[0]
+	if standup.ParseError != nil {
+		t.Fatalf("Expected standup to parse, got: %v", standup.ParseError)
+	}
+
+	if standup.UID != "standup-123@example.com" {
+		t.Errorf("Expected UID 'standup-123@example.com', got: %s", standup.UID)
+	}
+
+	if standup.Summary != "Daily standup, team A" {
+		t.Errorf("Expected unescaped summary, got: %q", standup.Summary)
+	}
+
+	if standup.Description != "Line one\nLine two that is folded across two lines" {
+		t.Errorf("Expected unfolded description, got: %q", standup.Description)
+	}
+
+	// 09:30 London summer time is 08:30 UTC
+	if want := time.Date(2024, 7, 15, 8, 30, 0, 0, time.UTC); !standup.Start.Equal(want) {
+		t.Errorf("Expected start %v, got: %v", want, standup.Start)
+	}
+
+	if standup.End.Sub(standup.Start) != 15*time.Minute {
+		t.Errorf("Expected 15 minute duration, got: %v", standup.End.Sub(standup.Start))
+	}
+
+	if standup.TimeZone != "Europe/London" {
+		t.Errorf("Expected timezone 'Europe/London', got: %s", standup.TimeZone)
+	}
+
+	if len(standup.Recurrence) != 1 || standup.Recurrence[0] != "RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR" {
+		t.Errorf("Expected RRULE to be preserved, got: %v", standup.Recurrence)
+	}
+
+	if standup.Organizer != "alice@example.com" {
+		t.Errorf("Expected organizer 'alice@example.com', got: %s", standup.Organizer)
+	}
+
+	if standup.Transparency != calendar.EventTransparencyTransparent {
+		t.Errorf("Expected transparency 'transparent', got: %s", standup.Transparency)
+	}
+
+	if len(standup.Attendees) != 3 {
+		t.Fatalf("Expected 3 attendees, got: %d", len(standup.Attendees))
+	}
+
+	bob := standup.Attendees[0]
+	if bob.Email != "bob@example.com" || bob.DisplayName != "Bob; Jr" || bob.ResponseStatus != calendar.ResponseStatusAccepted {
+		t.Errorf("Unexpected attendee: %+v", bob)
+	}
+
+	if !standup.Attendees[1].Optional || standup.Attendees[1].ResponseStatus != calendar.ResponseStatusTentative {
+		t.Errorf("Expected optional tentative attendee, got: %+v", standup.Attendees[1])
+	}
+
+	if !standup.Attendees[2].Resource {
+		t.Errorf("Expected room attendee to be a resource, got: %+v", standup.Attendees[2])
+	}
+
+	wantReminders := []calendar.EventReminder{
+		{Method: calendar.ReminderMethodPopup, Minutes: 10},
+		{Method: calendar.ReminderMethodEmail, Minutes: 1440},
+	}
+	if len(standup.Reminders) != len(wantReminders) {
+		t.Fatalf("Expected %d reminders, got: %v", len(wantReminders), standup.Reminders)
+	}
+	for i, want := range wantReminders {
+		if standup.Reminders[i] != want {
+			t.Errorf("Expected reminder %+v, got: %+v", want, standup.Reminders[i])
+		}
+	}
+
+	holiday := events[1]
+	if holiday.ParseError != nil || !holiday.AllDay {
+		t.Errorf("Expected all-day holiday, got: %+v", holiday)
+	}
+
+	if holiday.End.Sub(holiday.Start) != 24*time.Hour {
+		t.Errorf("Expected all-day event to default to one day, got: %v", holiday.End.Sub(holiday.Start))
+	}
+
+	if events[2].ParseError == nil {
+		t.Error("Expected an error for the event without a UID")
+	}
+}
+
+func TestParseICSInvalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"BEGIN:VEVENT\r\nUID:x\r\nEND:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:x\r\nEND:VCALENDAR\r\n",
+		"BEGIN:VCALENDAR\r\nnot a content line\r\nEND:VCALENDAR\r\n",
+	}
+
+	for _, data := range invalid {
+		if _, err := calendar.ParseICS(data, time.UTC); err == nil {
+			t.Errorf("Expected error for ICS data %q", data)
+		}
+	}
+}
+
+func TestFormatICSRoundTrip(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("Timezone database not available: %v", err)
+	}
+
+	seriesStart := time.Date(2024, 3, 25, 9, 30, 0, 0, london)
+	cancelledStart := seriesStart.AddDate(0, 0, 1)
+	events := []*calendar.Event{
+		{
+			ID:           "series1",
+			ICalUID:      "series1@google.com",
+			Summary:      "Standup; daily, with a long title that needs folding across several content lines",
+			Description:  "Line one\nLine two",
+			StartTime:    seriesStart,
+			EndTime:      seriesStart.Add(15 * time.Minute),
+			TimeZone:     "Europe/London",
+			Status:       calendar.EventStatusConfirmed,
+			Recurrence:   []string{"RRULE:FREQ=DAILY;COUNT=10"},
+			Organizer:    "alice@example.com",
+			Transparency: calendar.EventTransparencyOpaque,
+			AttendeeDetails: []calendar.Attendee{
+				{Email: "bob@example.com", DisplayName: "Bob", ResponseStatus: calendar.ResponseStatusAccepted},
+				{Email: "carol@example.com", Optional: true},
+			},
+			Reminders: &calendar.Reminders{Overrides: []calendar.EventReminder{
+				{Method: calendar.ReminderMethodPopup, Minutes: 10},
+			}},
+		},
+		{
+			ID:                "series1_20240326T083000Z",
+			Status:            calendar.EventStatusCancelled,
+			RecurringEventID:  "series1",
+			OriginalStartTime: &cancelledStart,
+		},
+		{
+			ID:        "holiday",
+			ICalUID:   "holiday@google.com",
+			Summary:   "Holiday",
+			StartTime: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC),
+			AllDay:    true,
+			Status:    calendar.EventStatusConfirmed,
+		},
+	}
+
+	data := calendar.FormatICS(events, "Europe/London",
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))
+
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("Expected lines of at most 75 octets, got %d: %q", len(line), line)
+		}
+	}
+
+	for _, want := range []string{
+		"BEGIN:VTIMEZONE\r\nTZID:Europe/London\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20240331T010000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0100\r\n",
+		"DTSTART;TZID=Europe/London:20240325T093000\r\n",
+		"EXDATE;TZID=Europe/London:20240326T093000\r\n",
+		"DTSTART;VALUE=DATE:20240401\r\n",
+	} {
+		if !strings.Contains(data, want) {
+			t.Errorf("Expected export to contain %q, got:\n%s", want, data)
+		}
+	}
+
+	parsed, err := calendar.ParseICS(data, time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to parse exported ICS: %v", err)
+	}
+
+	if len(parsed) != 2 {
+		t.Fatalf("Expected 2 exported events, got: %d", len(parsed))
+	}
+
+	series := parsed[0]
+	if series.ParseError != nil {
+		t.Fatalf("Expected exported series to parse, got: %v", series.ParseError)
+	}
+
+	if series.UID != "series1@google.com" || series.Summary != events[0].Summary || series.Description != events[0].Description {
+		t.Errorf("Expected identity and text to round-trip, got: %+v", series)
+	}
+
+	if !series.Start.Equal(seriesStart) || !series.End.Equal(events[0].EndTime) {
+		t.Errorf("Expected times to round-trip, got: %v - %v", series.Start, series.End)
+	}
+
+	if len(series.Recurrence) != 2 {
+		t.Errorf("Expected RRULE and EXDATE, got: %v", series.Recurrence)
+	}
+
+	if len(series.Attendees) != 2 || !series.Attendees[1].Optional || series.Attendees[0].ResponseStatus != calendar.ResponseStatusAccepted {
+		t.Errorf("Expected attendees to round-trip, got: %+v", series.Attendees)
+	}
+
+	if len(series.Reminders) != 1 || series.Reminders[0].Minutes != 10 {
+		t.Errorf("Expected reminder to round-trip, got: %+v", series.Reminders)
+	}
+
+	if !parsed[1].AllDay {
+		t.Errorf("Expected all-day event to round-trip, got: %+v", parsed[1])
+	}
+}
+
+func TestFormatICSTimeZoneBeforeRange(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("Timezone database not available: %v", err)
+	}
+
+	// A weekly series that began in winter, exported for a range in summer
+	seriesStart := time.Date(2024, 1, 8, 9, 0, 0, 0, london)
+	events := []*calendar.Event{{
+		ID:         "weekly",
+		Summary:    "Weekly review",
+		StartTime:  seriesStart,
+		EndTime:    seriesStart.Add(time.Hour),
+		TimeZone:   "Europe/London",
+		Status:     calendar.EventStatusConfirmed,
+		Recurrence: []string{"RRULE:FREQ=WEEKLY"},
+	}}
+
+	data := calendar.FormatICS(events, "Europe/London",
+		time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	// The first observance must cover the series start, in winter time
+	for _, want := range []string{
+		"BEGIN:STANDARD\r\nDTSTART:20240108T090000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0000\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20240331T010000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0100\r\n",
+		"DTSTART;TZID=Europe/London:20240108T090000\r\n",
+	} {
+		if !strings.Contains(data, want) {
+			t.Errorf("Expected export to contain %q, got:\n%s", want, data)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"testing"
+
+	"google_cal_mcp_golang/calendar"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+)
+
+// listedEvents builds events covering every combination the list filters look at
+func listedEvents() map[string]*googlecalendar.Event {
+	me := &googlecalendar.EventAttendee{Email: "me@example.com", Self: true, ResponseStatus: calendar.ResponseStatusAccepted}
+	declined := &googlecalendar.EventAttendee{Email: "me@example.com", Self: true, ResponseStatus: calendar.ResponseStatusDeclined}
+	other := &googlecalendar.EventAttendee{Email: "bob@example.com"}
+
+	return map[string]*googlecalendar.Event{
+		"solo":         {Id: "solo", Organizer: &googlecalendar.EventOrganizer{Self: true}},
+		"mine":         {Id: "mine", Organizer: &googlecalendar.EventOrganizer{Self: true}, Attendees: []*googlecalendar.EventAttendee{me, other}},
+		"invited":      {Id: "invited", Organizer: &googlecalendar.EventOrganizer{Email: "bob@example.com"}, Attendees: []*googlecalendar.EventAttendee{me, other}},
+		"declined":     {Id: "declined", Organizer: &googlecalendar.EventOrganizer{Email: "bob@example.com"}, Attendees: []*googlecalendar.EventAttendee{declined, other}},
+		"no-organizer": {Id: "no-organizer"},
+	}
+}
+
+func TestMatchesListFilters(t *testing.T) {
+	events := listedEvents()
+
+	tests := []struct {
+		name string
+		req  calendar.ListEventsRequest
+		want []string
+	}{
+		{name: "no filters", req: calendar.ListEventsRequest{}, want: []string{"solo", "mine", "invited", "declined", "no-organizer"}},
+		{name: "hide declined", req: calendar.ListEventsRequest{HideDeclined: true}, want: []string{"solo", "mine", "invited", "no-organizer"}},
+		{name: "organized by me", req: calendar.ListEventsRequest{OrganizedByMe: true}, want: []string{"solo", "mine"}},
+		{name: "with attendees only", req: calendar.ListEventsRequest{WithAttendeesOnly: true}, want: []string{"mine", "invited", "declined"}},
+		{
+			name: "filters combine",
+			req:  calendar.ListEventsRequest{HideDeclined: true, WithAttendeesOnly: true, OrganizedByMe: true},
+			want: []string{"mine"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := 0
+			for name, event := range events {
+				want := containsName(tt.want, name)
+				if got := calendar.MatchesListFilters(event, &tt.req); got != want {
+					t.Errorf("Expected %s to match: %v, got: %v", name, want, got)
+				}
+				if want {
+					matched++
+				}
+			}
+			if matched != len(tt.want) {
+				t.Errorf("Expected %d matching events, got %d", len(tt.want), matched)
+			}
+		})
+	}
+}
+
+func TestFilterListedEventsAcrossPages(t *testing.T) {
+	events := listedEvents()
+	pages := [][]*googlecalendar.Event{
+		{events["declined"], events["mine"], events["declined"]},
+		{events["declined"], events["invited"], events["solo"], events["mine"]},
+	}
+	req := &calendar.ListEventsRequest{HideDeclined: true}
+
+	// Read pages as ListEvents does until three events pass the filter
+	const maxResults = 3
+	var ids []string
+	for _, page := range pages {
+		for _, event := range calendar.FilterListedEvents(page, req, maxResults-len(ids)) {
+			ids = append(ids, event.Id)
+		}
+		if len(ids) == maxResults {
+			break
+		}
+	}
+
+	want := []string{"mine", "invited", "solo"}
+	if len(ids) != len(want) {
+		t.Fatalf("Expected %v, got: %v", want, ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("Expected %v, got: %v", want, ids)
+		}
+	}
+
+	if got := calendar.FilterListedEvents(pages[1], req, 0); len(got) != 0 {
+		t.Errorf("Expected no events once the limit is reached, got: %d", len(got))
+	}
+}
+
+// containsName reports whether names contains name
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
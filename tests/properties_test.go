@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"testing"
+
+	"google_cal_mcp_golang/calendar"
+)
+
+func TestParseKeyValuePairs(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", input: "", want: map[string]string{}},
+		{
+			name:  "pairs are trimmed and empty values kept",
+			input: " source = agent ,ticket=PROJ-1, stale=",
+			want:  map[string]string{"source": "agent", "ticket": "PROJ-1", "stale": ""},
+		},
+		{name: "value may contain equals signs", input: "query=a=b", want: map[string]string{"query": "a=b"}},
+		{name: "missing equals sign", input: "source", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calendar.ParseKeyValuePairs(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got: %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			checkProperties(t, got, tt.want)
+		})
+	}
+}
+
+func TestValidateExtendedProperties(t *testing.T) {
+	tests := []struct {
+		name    string
+		props   *calendar.ExtendedProperties
+		wantErr bool
+	}{
+		{name: "nil", props: nil},
+		{name: "valid keys", props: &calendar.ExtendedProperties{Private: map[string]string{"source": "agent"}, Shared: map[string]string{"ticket": "PROJ-1"}}},
+		{name: "empty key", props: &calendar.ExtendedProperties{Private: map[string]string{"": "agent"}}, wantErr: true},
+		{name: "key with equals sign", props: &calendar.ExtendedProperties{Shared: map[string]string{"a=b": "c"}}, wantErr: true},
+		{name: "key with comma", props: &calendar.ExtendedProperties{Private: map[string]string{"a,b": "c"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := calendar.ValidateExtendedProperties(tt.props)
+			if tt.wantErr && err == nil {
+				t.Error("Expected an error, got none")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestMergeProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing map[string]string
+		updates  map[string]string
+		want     map[string]string
+	}{
+		{name: "nil existing", existing: nil, updates: map[string]string{"a": "1"}, want: map[string]string{"a": "1"}},
+		{
+			name:     "values are added and replaced",
+			existing: map[string]string{"a": "1", "b": "2"},
+			updates:  map[string]string{"b": "3", "c": "4"},
+			want:     map[string]string{"a": "1", "b": "3", "c": "4"},
+		},
+		{
+			name:     "empty values remove keys",
+			existing: map[string]string{"a": "1", "b": "2"},
+			updates:  map[string]string{"a": "", "missing": ""},
+			want:     map[string]string{"b": "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkProperties(t, calendar.MergeProperties(tt.existing, tt.updates), tt.want)
+		})
+	}
+}
+
+// checkProperties reports keys whose values differ from want
+func checkProperties(t *testing.T, got, want map[string]string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got: %v", want, got)
+	}
+	for key, value := range want {
+		if gotValue, ok := got[key]; !ok || gotValue != value {
+			t.Errorf("Expected %s=%q, got: %v", key, value, got)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"testing"
+
+	"google_cal_mcp_golang/calendar"
+)
+
+func TestParseReminders(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []calendar.EventReminder
+		wantErr bool
+	}{
+		{name: "empty", input: "", want: nil},
+		{
+			name:  "methods are lower-cased and spaces trimmed",
+			input: " Popup:10 , EMAIL: 1440,",
+			want: []calendar.EventReminder{
+				{Method: "popup", Minutes: 10},
+				{Method: "email", Minutes: 1440},
+			},
+		},
+		{name: "missing minutes", input: "popup", wantErr: true},
+		{name: "non-numeric minutes", input: "popup:ten", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calendar.ParseReminders(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got: %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %d reminders, got: %+v", len(tt.want), got)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Expected reminder %+v, got: %+v", tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateReminders(t *testing.T) {
+	popup := func(minutes int) calendar.EventReminder {
+		return calendar.EventReminder{Method: calendar.ReminderMethodPopup, Minutes: minutes}
+	}
+
+	tests := []struct {
+		name      string
+		reminders []calendar.EventReminder
+		wantErr   bool
+	}{
+		{name: "none", reminders: nil},
+		{name: "zero minutes", reminders: []calendar.EventReminder{popup(0)}},
+		{name: "four weeks", reminders: []calendar.EventReminder{popup(calendar.MaxReminderMinutes)}},
+		{name: "over four weeks", reminders: []calendar.EventReminder{popup(calendar.MaxReminderMinutes + 1)}, wantErr: true},
+		{name: "negative minutes", reminders: []calendar.EventReminder{popup(-1)}, wantErr: true},
+		{name: "email", reminders: []calendar.EventReminder{{Method: calendar.ReminderMethodEmail, Minutes: 60}}},
+		{name: "unknown method", reminders: []calendar.EventReminder{{Method: "sms", Minutes: 10}}, wantErr: true},
+		{name: "five overrides", reminders: []calendar.EventReminder{popup(1), popup(2), popup(3), popup(4), popup(5)}},
+		{name: "six overrides", reminders: []calendar.EventReminder{popup(1), popup(2), popup(3), popup(4), popup(5), popup(6)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := calendar.ValidateReminders(tt.reminders)
+			if tt.wantErr && err == nil {
+				t.Error("Expected an error, got none")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"google_cal_mcp_golang/calendar"
+)
+
+func TestRoomCatalogConfig(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("GOOGLE_CALENDAR_CREDENTIALS_JSON", `{"type": "service_account", "project_id": "test"}`)
+	os.Setenv("GOOGLE_CALENDAR_ROOMS", "../docs/rooms.example.json")
+
+	config, err := calendar.LoadConfig()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	if len(config.Rooms) != 3 {
+		t.Fatalf("Expected 3 rooms in the example catalog, got: %d", len(config.Rooms))
+	}
+
+	tests := []struct {
+		name  string
+		req   calendar.RoomSearchRequest
+		rooms []string
+	}{
+		{"no filter", calendar.RoomSearchRequest{}, []string{"London-HQ-3-Thames (8) [Video]", "London-HQ-3-Fleet (4)", "Paris-1-Seine (12) [Video]"}},
+		{"capacity", calendar.RoomSearchRequest{MinCapacity: 6}, []string{"London-HQ-3-Thames (8) [Video]", "Paris-1-Seine (12) [Video]"}},
+		{"building", calendar.RoomSearchRequest{Building: "london-hq"}, []string{"London-HQ-3-Thames (8) [Video]", "London-HQ-3-Fleet (4)"}},
+		{"features", calendar.RoomSearchRequest{Features: []string{"whiteboard", "video conferencing"}}, []string{"London-HQ-3-Thames (8) [Video]"}},
+		{"no match", calendar.RoomSearchRequest{Building: "Paris", Features: []string{"Whiteboard"}}, nil},
+	}
+
+	for _, tt := range tests {
+		rooms := calendar.FilterRooms(config.Rooms, &tt.req)
+		if len(rooms) != len(tt.rooms) {
+			t.Errorf("%s: expected %d rooms, got: %+v", tt.name, len(tt.rooms), rooms)
+			continue
+		}
+		for i, name := range tt.rooms {
+			if rooms[i].Name != name {
+				t.Errorf("%s: expected room %q, got %q", tt.name, name, rooms[i].Name)
+			}
+		}
+	}
+}
+
+func TestInvalidRoomCatalog(t *testing.T) {
+	invalid := []string{
+		`[{"name": "No email"}]`,
+		`[{"email": "room@example.com", "capacity": "ten"}]`,
+		"missing-rooms.json",
+	}
+
+	for _, value := range invalid {
+		os.Clearenv()
+		os.Setenv("GOOGLE_CALENDAR_CREDENTIALS_JSON", `{"type": "service_account", "project_id": "test"}`)
+		os.Setenv("GOOGLE_CALENDAR_ROOMS", value)
+
+		if _, err := calendar.LoadConfig(); err == nil {
+			t.Errorf("Expected error for room catalog %s", value)
+		}
+	}
+}
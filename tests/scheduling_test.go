@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestRankMeetingSlots(t *testing.T) {
+	// Monday 22 July: Alice is busy 09:00-10:00 and 10:30-12:00, Bob 13:00-17:00
+	freeBusy := &calendar.FreeBusyResult{
+		StartTime: at(22, 0, 0),
+		EndTime:   at(24, 0, 0),
+		Calendars: []calendar.CalendarBusy{
+			{CalendarID: "alice@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 10, 30), End: at(22, 12, 0)},
+			}},
+			{CalendarID: "bob@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 13, 0), End: at(22, 17, 0)},
+			}},
+			{CalendarID: "carol@other.example.com", Errors: []string{"notFound"}},
+		},
+	}
+
+	req := &calendar.MeetingSuggestionRequest{
+		Duration:       time.Hour,
+		StartTime:      at(22, 0, 0),
+		EndTime:        at(24, 0, 0),
+		WorkingHours:   &calendar.TimeOfDayRange{Start: 9 * 60, End: 17 * 60},
+		PreferredDays:  []time.Weekday{time.Tuesday},
+		PreferredTimes: []calendar.TimeOfDayRange{{Start: 14 * 60, End: 16 * 60}},
+		MaxSuggestions: 10,
+	}
+
+	result := calendar.RankMeetingSlots(req, freeBusy, time.UTC)
+
+	if len(result.UnknownAvailability) != 1 || result.UnknownAvailability[0] != "carol@other.example.com" {
+		t.Errorf("Expected carol's calendar to be reported as unknown, got: %v", result.UnknownAvailability)
+	}
+
+	if len(result.Suggestions) == 0 {
+		t.Fatal("Expected suggestions, got none")
+	}
+
+	best := result.Suggestions[0]
+	if !best.Start.Equal(at(23, 14, 0)) {
+		t.Errorf("Expected best slot on Tuesday at 14:00, got: %v (%v)", best.Start, best.Reasons)
+	}
+
+	busy := append(freeBusy.Calendars[0].Busy, freeBusy.Calendars[1].Busy...)
+	for i, suggestion := range result.Suggestions {
+		if suggestion.End.Sub(suggestion.Start) != time.Hour {
+			t.Errorf("Expected 1 hour suggestions, got: %v - %v", suggestion.Start, suggestion.End)
+		}
+
+		if suggestion.Start.Hour() < 9 || suggestion.End.After(time.Date(2024, 7, suggestion.Start.Day(), 17, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expected suggestion within working hours, got: %v - %v", suggestion.Start, suggestion.End)
+		}
+
+		for _, block := range busy {
+			if suggestion.Start.Before(block.End) && block.Start.Before(suggestion.End) {
+				t.Errorf("Suggestion %v - %v overlaps busy block %v - %v", suggestion.Start, suggestion.End, block.Start, block.End)
+			}
+		}
+
+		for _, other := range result.Suggestions[i+1:] {
+			if suggestion.Start.Before(other.End) && other.Start.Before(suggestion.End) {
+				t.Errorf("Suggestions overlap: %v and %v", suggestion.Start, other.Start)
+			}
+			if other.Score > suggestion.Score {
+				t.Errorf("Expected suggestions ordered by score, got %.1f before %.1f", suggestion.Score, other.Score)
+			}
+		}
+	}
+
+	// The only free Monday hour is 12:00-13:00
+	foundMonday := false
+	for _, suggestion := range result.Suggestions {
+		if suggestion.Start.Day() == 22 {
+			foundMonday = true
+			if !suggestion.Start.Equal(at(22, 12, 0)) {
+				t.Errorf("Expected the only Monday slot at 12:00, got: %v", suggestion.Start)
+			}
+		}
+	}
+	if !foundMonday {
+		t.Error("Expected the Monday 12:00 slot to be suggested")
+	}
+}
+
+func TestRankMeetingSlotsConstraints(t *testing.T) {
+	freeBusy := &calendar.FreeBusyResult{
+		Calendars: []calendar.CalendarBusy{
+			{CalendarID: "alice@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+				{Start: at(22, 11, 0), End: at(22, 12, 0)},
+				{Start: at(23, 10, 0), End: at(23, 11, 0)},
+			}},
+		},
+	}
+
+	req := &calendar.MeetingSuggestionRequest{
+		Duration:          30 * time.Minute,
+		StartTime:         at(22, 0, 0),
+		EndTime:           at(24, 0, 0),
+		WorkingHours:      &calendar.TimeOfDayRange{Start: 9 * 60, End: 12 * 60},
+		BufferBefore:      15 * time.Minute,
+		BufferAfter:       15 * time.Minute,
+		MaxMeetingsPerDay: 2,
+		MaxSuggestions:    10,
+	}
+
+	result := calendar.RankMeetingSlots(req, freeBusy, time.UTC)
+
+	// Monday already has two meetings, and on Tuesday the buffers leave 09:00-09:30 and 11:15-11:45
+	want := []time.Time{at(23, 9, 0), at(23, 11, 15)}
+	if len(result.Suggestions) != len(want) {
+		t.Fatalf("Expected %d suggestions, got: %+v", len(want), result.Suggestions)
+	}
+	for i, start := range want {
+		if !result.Suggestions[i].Start.Equal(start) {
+			t.Errorf("Expected suggestion %d at %v, got: %v", i, start, result.Suggestions[i].Start)
+		}
+	}
+}
+
+func TestRankMeetingSlotsOptionalAttendees(t *testing.T) {
+	// Alice is busy 09:00-10:00 with the event being moved and optional Bob 10:00-11:00
+	freeBusy := &calendar.FreeBusyResult{
+		StartTime: at(22, 0, 0),
+		EndTime:   at(23, 0, 0),
+		Calendars: []calendar.CalendarBusy{
+			{CalendarID: "alice@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+			}},
+			{CalendarID: "bob@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 10, 0), End: at(22, 11, 0)},
+			}},
+		},
+	}
+	moved := &calendar.MovedEvent{
+		EventID:   "review",
+		Previous:  calendar.TimeSlot{Start: at(22, 9, 0), End: at(22, 10, 0)},
+		Calendars: []string{"alice@example.com"},
+	}
+	if err := calendar.ExcludeMovedEvent(freeBusy, moved, nil, time.UTC); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	req := &calendar.MeetingSuggestionRequest{
+		Attendees:         []string{"alice@example.com"},
+		OptionalAttendees: []string{"bob@example.com"},
+		Duration:          time.Hour,
+		StartTime:         at(22, 0, 0),
+		EndTime:           at(23, 0, 0),
+		WorkingHours:      &calendar.TimeOfDayRange{Start: 9 * 60, End: 11 * 60},
+		MaxSuggestions:    10,
+	}
+
+	result := calendar.RankMeetingSlots(req, freeBusy, time.UTC)
+
+	// The moved event frees 09:00, and Bob being busy lowers 10:00 without ruling it out
+	scores := make(map[time.Time]float64)
+	for _, suggestion := range result.Suggestions {
+		scores[suggestion.Start] = suggestion.Score
+	}
+	free, freeOK := scores[at(22, 9, 0)]
+	busy, busyOK := scores[at(22, 10, 0)]
+	if !freeOK || !busyOK {
+		t.Fatalf("Expected slots at 09:00 and 10:00, got: %+v", result.Suggestions)
+	}
+	if busy >= free {
+		t.Errorf("Expected the slot with optional attendees busy to score lower, got %.1f and %.1f", busy, free)
+	}
+}
+
+func TestRankMeetingSlotsMovedEventOverlap(t *testing.T) {
+	event := func(id string, start, end time.Time) *googlecalendar.Event {
+		return &googlecalendar.Event{
+			Id:    id,
+			Start: &googlecalendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+			End:   &googlecalendar.EventDateTime{DateTime: end.Format(time.RFC3339)},
+		}
+	}
+
+	// The review at 09:00-10:00 is being moved, but Alice also has a call at 09:30-10:00
+	freeBusy := &calendar.FreeBusyResult{
+		StartTime: at(22, 0, 0),
+		EndTime:   at(23, 0, 0),
+		Calendars: []calendar.CalendarBusy{
+			{CalendarID: "alice@example.com", Busy: []calendar.TimeSlot{
+				{Start: at(22, 9, 0), End: at(22, 10, 0)},
+			}},
+		},
+	}
+	moved := &calendar.MovedEvent{
+		EventID:   "review",
+		Previous:  calendar.TimeSlot{Start: at(22, 9, 0), End: at(22, 10, 0)},
+		Calendars: []string{"alice@example.com"},
+	}
+	calendarEvents := map[string][]*googlecalendar.Event{
+		"alice@example.com": {
+			event("review", at(22, 9, 0), at(22, 10, 0)),
+			event("call", at(22, 9, 30), at(22, 10, 0)),
+		},
+	}
+	if err := calendar.ExcludeMovedEvent(freeBusy, moved, calendarEvents, time.UTC); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	req := &calendar.MeetingSuggestionRequest{
+		Attendees:      []string{"alice@example.com"},
+		Duration:       30 * time.Minute,
+		StartTime:      at(22, 0, 0),
+		EndTime:        at(23, 0, 0),
+		WorkingHours:   &calendar.TimeOfDayRange{Start: 9 * 60, End: 11 * 60},
+		MaxSuggestions: 10,
+	}
+
+	result := calendar.RankMeetingSlots(req, freeBusy, time.UTC)
+
+	if len(result.Suggestions) == 0 {
+		t.Fatal("Expected suggestions, got none")
+	}
+	for _, suggestion := range result.Suggestions {
+		if suggestion.Start.Before(at(22, 10, 0)) && suggestion.End.After(at(22, 9, 30)) {
+			t.Errorf("Suggestion %v - %v double-books Alice's call", suggestion.Start, suggestion.End)
+		}
+	}
+	if !containsStart(result.Suggestions, at(22, 9, 0)) {
+		t.Errorf("Expected the freed 09:00 slot to be suggested, got: %+v", result.Suggestions)
+	}
+}
+
+// containsStart reports whether a suggestion starts at start
+func containsStart(suggestions []calendar.MeetingSuggestion, start time.Time) bool {
+	for _, suggestion := range suggestions {
+		if suggestion.Start.Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRankMeetingSlotsLocalAlignment(t *testing.T) {
+	// An offset that is not a multiple of the slot step, like historical local mean time
+	loc := time.FixedZone("LMT", 19*60+32)
+	req := &calendar.MeetingSuggestionRequest{
+		Duration:       30 * time.Minute,
+		StartTime:      time.Date(2024, 7, 22, 9, 0, 0, 0, loc),
+		EndTime:        time.Date(2024, 7, 22, 12, 0, 0, 0, loc),
+		WorkingHours:   &calendar.TimeOfDayRange{Start: 9 * 60, End: 12 * 60},
+		MaxSuggestions: 20,
+	}
+
+	result := calendar.RankMeetingSlots(req, &calendar.FreeBusyResult{}, loc)
+
+	if len(result.Suggestions) == 0 {
+		t.Fatal("Expected suggestions, got none")
+	}
+	for _, suggestion := range result.Suggestions {
+		local := suggestion.Start.In(loc)
+		if local.Minute()%15 != 0 || local.Second() != 0 {
+			t.Errorf("Expected suggestions on the local quarter hour, got: %v", local)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"google_cal_mcp_golang/calendar"
+)
+
+const workingHoursJSON = `{
+  "default": {
+    "timezone": "Europe/London",
+    "days": {"monday": ["09:00-17:30"], "tuesday": ["09:00-17:30"], "friday": ["09:00-13:00"]},
+    "lunch": "12:30-13:30"
+  },
+  "people": {
+    "bob@example.com": {"timezone": "America/New_York"}
+  }
+}`
+
+func TestWorkingHoursConfig(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("GOOGLE_CALENDAR_CREDENTIALS_JSON", `{"type": "service_account", "project_id": "test"}`)
+	os.Setenv("GOOGLE_CALENDAR_WORKING_HOURS", workingHoursJSON)
+
+	config, err := calendar.LoadConfig()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	owner, err := config.WorkingHoursFor(config.CalendarID)
+	if err != nil {
+		t.Fatalf("Failed to resolve working hours: %v", err)
+	}
+
+	if owner.Location.String() != "Europe/London" {
+		t.Errorf("Expected timezone 'Europe/London', got: %s", owner.Location)
+	}
+
+	// Lunch splits Monday in two and trims Friday; unlisted days are not worked
+	monday := owner.Days[time.Monday]
+	if len(monday) != 2 || monday[0].String() != "09:00-12:30" || monday[1].String() != "13:30-17:30" {
+		t.Errorf("Expected Monday 09:00-12:30 and 13:30-17:30, got: %v", monday)
+	}
+
+	if friday := owner.Days[time.Friday]; len(friday) != 1 || friday[0].String() != "09:00-12:30" {
+		t.Errorf("Expected Friday 09:00-12:30, got: %v", friday)
+	}
+
+	if len(owner.Days[time.Wednesday]) != 0 {
+		t.Errorf("Expected Wednesday not to be worked, got: %v", owner.Days[time.Wednesday])
+	}
+
+	// Bob has his own timezone and the default Monday to Friday 09:00-17:00
+	bob, err := config.WorkingHoursFor("Bob@Example.com")
+	if err != nil {
+		t.Fatalf("Failed to resolve Bob's working hours: %v", err)
+	}
+
+	if bob.Location.String() != "America/New_York" {
+		t.Errorf("Expected Bob's timezone 'America/New_York', got: %s", bob.Location)
+	}
+
+	if wednesday := bob.Days[time.Wednesday]; len(wednesday) != 1 || wednesday[0].String() != "09:00-17:00" {
+		t.Errorf("Expected Bob to work Wednesday 09:00-17:00, got: %v", wednesday)
+	}
+
+	// Monday 15 July 2024 in UTC: London is UTC+1, so work runs 08:00-11:30 and 12:30-16:30
+	intervals := owner.Intervals(time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 16, 0, 0, 0, 0, time.UTC))
+	want := []calendar.TimeSlot{
+		{Start: time.Date(2024, 7, 15, 8, 0, 0, 0, time.UTC), End: time.Date(2024, 7, 15, 11, 30, 0, 0, time.UTC)},
+		{Start: time.Date(2024, 7, 15, 12, 30, 0, 0, time.UTC), End: time.Date(2024, 7, 15, 16, 30, 0, 0, time.UTC)},
+	}
+	if len(intervals) != len(want) {
+		t.Fatalf("Expected %d working intervals, got: %v", len(want), intervals)
+	}
+	for i := range want {
+		if !intervals[i].Start.Equal(want[i].Start) || !intervals[i].End.Equal(want[i].End) {
+			t.Errorf("Expected interval %v - %v, got: %v - %v", want[i].Start, want[i].End, intervals[i].Start, intervals[i].End)
+		}
+	}
+}
+
+func TestInvalidWorkingHoursConfig(t *testing.T) {
+	invalid := []string{
+		`{"default": {"days": {"someday": ["09:00-17:00"]}}}`,
+		`{"default": {"days": {"monday": ["17:00-09:00"]}}}`,
+		`{"people": {"bob@example.com": {"timezone": "Not/AZone"}}}`,
+		`{"default": `,
+	}
+
+	for _, value := range invalid {
+		os.Clearenv()
+		os.Setenv("GOOGLE_CALENDAR_CREDENTIALS_JSON", `{"type": "service_account", "project_id": "test"}`)
+		os.Setenv("GOOGLE_CALENDAR_WORKING_HOURS", value)
+
+		if _, err := calendar.LoadConfig(); err == nil {
+			t.Errorf("Expected error for working hours %s", value)
+		}
+	}
+}
+
+func TestRankMeetingSlotsAttendeeHours(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("Timezone database not available: %v", err)
+	}
+
+	weekdays := map[time.Weekday][]calendar.TimeOfDayRange{}
+	for day := time.Monday; day <= time.Friday; day++ {
+		weekdays[day] = []calendar.TimeOfDayRange{{Start: 9 * 60, End: 17 * 60}}
+	}
+
+	req := &calendar.MeetingSuggestionRequest{
+		Duration:       time.Hour,
+		StartTime:      at(22, 0, 0),
+		EndTime:        at(23, 0, 0),
+		MaxSuggestions: 20,
+		AttendeeHours: map[string]*calendar.WorkingHours{
+			"alice@example.com": {Location: time.UTC, Days: weekdays},
+			"bob@example.com":   {Location: newYork, Days: weekdays},
+		},
+	}
+	freeBusy := &calendar.FreeBusyResult{Calendars: []calendar.CalendarBusy{
+		{CalendarID: "alice@example.com"},
+		{CalendarID: "bob@example.com"},
+	}}
+
+	result := calendar.RankMeetingSlots(req, freeBusy, time.UTC)
+
+	// Alice works 09:00-17:00 UTC and Bob 13:00-21:00 UTC, so only 13:00-17:00 overlaps
+	if len(result.Suggestions) != 4 {
+		t.Fatalf("Expected 4 one-hour suggestions, got: %+v", result.Suggestions)
+	}
+	for _, suggestion := range result.Suggestions {
+		if suggestion.Start.Before(at(22, 13, 0)) || suggestion.End.After(at(22, 17, 0)) {
+			t.Errorf("Expected suggestion within 13:00-17:00 UTC, got: %v - %v", suggestion.Start, suggestion.End)
+		}
+	}
+}